@@ -0,0 +1,98 @@
+package autodiff
+
+import (
+	"math"
+)
+
+// Dual — дуальное число a + b·ε, ε² = 0. Поле Eps несёт производную.
+type Dual struct {
+	Val float64
+	Eps float64
+}
+
+// Variable возвращает независимую переменную x + 1·ε.
+func Variable(x float64) Dual { return Dual{Val: x, Eps: 1} }
+
+// Const возвращает константу c + 0·ε.
+func Const(c float64) Dual { return Dual{Val: c} }
+
+// Derivative вычисляет f(x) и f'(x) одним проходом.
+func Derivative(f func(Dual) Dual, x float64) (float64, float64) {
+	r := f(Variable(x))
+	return r.Val, r.Eps
+}
+
+// chain применяет правило цепочки: g(u) с g' = dg.
+func chain(u Dual, g, dg float64) Dual {
+	return Dual{Val: g, Eps: dg * u.Eps}
+}
+
+func Add(a, b Dual) Dual { return Dual{a.Val + b.Val, a.Eps + b.Eps} }
+func Sub(a, b Dual) Dual { return Dual{a.Val - b.Val, a.Eps - b.Eps} }
+func Mul(a, b Dual) Dual { return Dual{a.Val * b.Val, a.Eps*b.Val + a.Val*b.Eps} }
+func Div(a, b Dual) Dual {
+	return Dual{a.Val / b.Val, (a.Eps*b.Val - a.Val*b.Eps) / (b.Val * b.Val)}
+}
+func Neg(a Dual) Dual { return Dual{-a.Val, -a.Eps} }
+
+func Scale(a Dual, c float64) Dual    { return Dual{a.Val * c, a.Eps * c} }
+func AddConst(a Dual, c float64) Dual { return Dual{a.Val + c, a.Eps} }
+
+// PowConst вычисляет a^p для постоянного показателя.
+func PowConst(a Dual, p float64) Dual {
+	switch p {
+	case 0:
+		return Const(1)
+	case 1:
+		return a
+	case 2:
+		return Mul(a, a)
+	}
+	return chain(a, math.Pow(a.Val, p), p*math.Pow(a.Val, p-1))
+}
+
+// Pow вычисляет a^b = exp(b·ln a).
+func Pow(a, b Dual) Dual {
+	if b.Eps == 0 {
+		return PowConst(a, b.Val)
+	}
+	v := math.Pow(a.Val, b.Val)
+	return Dual{v, v * (b.Eps*math.Log(a.Val) + b.Val*a.Eps/a.Val)}
+}
+
+func Sqrt(a Dual) Dual {
+	s := math.Sqrt(a.Val)
+	return chain(a, s, 0.5/s)
+}
+func Cbrt(a Dual) Dual {
+	c := math.Cbrt(a.Val)
+	return chain(a, c, 1/(3*c*c))
+}
+func Exp(a Dual) Dual {
+	e := math.Exp(a.Val)
+	return chain(a, e, e)
+}
+func Log(a Dual) Dual   { return chain(a, math.Log(a.Val), 1/a.Val) }
+func Log10(a Dual) Dual { return chain(a, math.Log10(a.Val), 1/(a.Val*math.Ln10)) }
+func Log2(a Dual) Dual  { return chain(a, math.Log2(a.Val), 1/(a.Val*math.Ln2)) }
+func Sin(a Dual) Dual   { return chain(a, math.Sin(a.Val), math.Cos(a.Val)) }
+func Cos(a Dual) Dual   { return chain(a, math.Cos(a.Val), -math.Sin(a.Val)) }
+func Tan(a Dual) Dual {
+	c := math.Cos(a.Val)
+	return chain(a, math.Tan(a.Val), 1/(c*c))
+}
+func Asin(a Dual) Dual { return chain(a, math.Asin(a.Val), 1/math.Sqrt(1-a.Val*a.Val)) }
+func Acos(a Dual) Dual { return chain(a, math.Acos(a.Val), -1/math.Sqrt(1-a.Val*a.Val)) }
+func Atan(a Dual) Dual { return chain(a, math.Atan(a.Val), 1/(1+a.Val*a.Val)) }
+func Sinh(a Dual) Dual { return chain(a, math.Sinh(a.Val), math.Cosh(a.Val)) }
+func Cosh(a Dual) Dual { return chain(a, math.Cosh(a.Val), math.Sinh(a.Val)) }
+func Tanh(a Dual) Dual {
+	t := math.Tanh(a.Val)
+	return chain(a, t, 1-t*t)
+}
+func Abs(a Dual) Dual {
+	if a.Val < 0 {
+		return Neg(a)
+	}
+	return a
+}
@@ -0,0 +1,104 @@
+package autodiff
+
+import (
+	"math"
+)
+
+// HyperDual — гипердуальное число a + b·ε₁ + c·ε₂ + d·ε₁ε₂, ε₁² = ε₂² = 0.
+// При x = (x, 1, 1, 0) коэффициент D равен второй производной.
+type HyperDual struct {
+	A, B, C, D float64
+}
+
+func HyperVariable(x float64) HyperDual { return HyperDual{A: x, B: 1, C: 1} }
+func HyperConst(c float64) HyperDual    { return HyperDual{A: c} }
+
+// SecondDerivative вычисляет f(x), f'(x) и f”(x) одним проходом.
+func SecondDerivative(f func(HyperDual) HyperDual, x float64) (float64, float64, float64) {
+	r := f(HyperVariable(x))
+	return r.A, r.B, r.D
+}
+
+// hchain применяет правило цепочки второго порядка: g(u), g'(u) = g1, g”(u) = g2.
+func hchain(u HyperDual, g, g1, g2 float64) HyperDual {
+	return HyperDual{
+		A: g,
+		B: g1 * u.B,
+		C: g1 * u.C,
+		D: g1*u.D + g2*u.B*u.C,
+	}
+}
+
+func HAdd(a, b HyperDual) HyperDual { return HyperDual{a.A + b.A, a.B + b.B, a.C + b.C, a.D + b.D} }
+func HSub(a, b HyperDual) HyperDual { return HyperDual{a.A - b.A, a.B - b.B, a.C - b.C, a.D - b.D} }
+func HMul(a, b HyperDual) HyperDual {
+	return HyperDual{
+		A: a.A * b.A,
+		B: a.A*b.B + a.B*b.A,
+		C: a.A*b.C + a.C*b.A,
+		D: a.A*b.D + a.B*b.C + a.C*b.B + a.D*b.A,
+	}
+}
+func HDiv(a, b HyperDual) HyperDual {
+	inv := b.A
+	return HMul(a, hchain(b, 1/inv, -1/(inv*inv), 2/(inv*inv*inv)))
+}
+func HNeg(a HyperDual) HyperDual { return HyperDual{-a.A, -a.B, -a.C, -a.D} }
+
+func HScale(a HyperDual, c float64) HyperDual {
+	return HyperDual{a.A * c, a.B * c, a.C * c, a.D * c}
+}
+func HAddConst(a HyperDual, c float64) HyperDual { return HyperDual{a.A + c, a.B, a.C, a.D} }
+
+func HPowConst(a HyperDual, p float64) HyperDual {
+	switch p {
+	case 0:
+		return HyperConst(1)
+	case 1:
+		return a
+	case 2:
+		return HMul(a, a)
+	}
+	return hchain(a, math.Pow(a.A, p), p*math.Pow(a.A, p-1), p*(p-1)*math.Pow(a.A, p-2))
+}
+
+func HSqrt(a HyperDual) HyperDual {
+	s := math.Sqrt(a.A)
+	return hchain(a, s, 0.5/s, -0.25/(s*a.A))
+}
+func HExp(a HyperDual) HyperDual {
+	e := math.Exp(a.A)
+	return hchain(a, e, e, e)
+}
+func HLog(a HyperDual) HyperDual {
+	return hchain(a, math.Log(a.A), 1/a.A, -1/(a.A*a.A))
+}
+func HSin(a HyperDual) HyperDual {
+	s, c := math.Sin(a.A), math.Cos(a.A)
+	return hchain(a, s, c, -s)
+}
+func HCos(a HyperDual) HyperDual {
+	s, c := math.Sin(a.A), math.Cos(a.A)
+	return hchain(a, c, -s, -c)
+}
+func HTan(a HyperDual) HyperDual {
+	t := math.Tan(a.A)
+	sec2 := 1 + t*t
+	return hchain(a, t, sec2, 2*t*sec2)
+}
+func HAtan(a HyperDual) HyperDual {
+	d := 1 + a.A*a.A
+	return hchain(a, math.Atan(a.A), 1/d, -2*a.A/(d*d))
+}
+func HSinh(a HyperDual) HyperDual {
+	s, c := math.Sinh(a.A), math.Cosh(a.A)
+	return hchain(a, s, c, s)
+}
+func HCosh(a HyperDual) HyperDual {
+	s, c := math.Sinh(a.A), math.Cosh(a.A)
+	return hchain(a, c, s, c)
+}
+func HTanh(a HyperDual) HyperDual {
+	t := math.Tanh(a.A)
+	return hchain(a, t, 1-t*t, -2*t*(1-t*t))
+}
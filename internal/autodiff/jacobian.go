@@ -0,0 +1,61 @@
+package autodiff
+
+import (
+	"fmt"
+)
+
+// Gradient вычисляет значение и градиент функции многих переменных,
+// выполняя по одному проходу на каждую переменную.
+func Gradient(f func([]Dual) Dual, x []float64) (float64, []float64) {
+	n := len(x)
+	args := make([]Dual, n)
+	grad := make([]float64, n)
+	value := 0.0
+	for j := 0; j < n; j++ {
+		for i := range args {
+			args[i] = Const(x[i])
+		}
+		args[j].Eps = 1
+		r := f(args)
+		value = r.Val
+		grad[j] = r.Eps
+	}
+	return value, grad
+}
+
+// Jacobian вычисляет F(x) и матрицу Якоби J[i][j] = ∂F_i/∂x_j.
+func Jacobian(F func([]Dual) []Dual, x []float64) ([]float64, [][]float64, error) {
+	n := len(x)
+	args := make([]Dual, n)
+	var fx []float64
+	var J [][]float64
+	for j := 0; j < n; j++ {
+		for i := range args {
+			args[i] = Const(x[i])
+		}
+		args[j].Eps = 1
+		r := F(args)
+		if j == 0 {
+			fx = make([]float64, len(r))
+			J = make([][]float64, len(r))
+			for i := range J {
+				J[i] = make([]float64, n)
+			}
+		} else if len(r) != len(fx) {
+			return nil, nil, fmt.Errorf("функция вернула векторы разной длины")
+		}
+		for i := range r {
+			fx[i] = r[i].Val
+			J[i][j] = r[i].Eps
+		}
+	}
+	if n == 0 {
+		r := F(args)
+		fx = make([]float64, len(r))
+		for i := range r {
+			fx[i] = r[i].Val
+		}
+		J = make([][]float64, len(r))
+	}
+	return fx, J, nil
+}
@@ -0,0 +1,184 @@
+package eigen
+
+import (
+	"fmt"
+	"math"
+)
+
+// Cholesky раскладывает симметричную положительно определённую матрицу B = L·Lᵀ.
+func Cholesky(B [][]float64) ([][]float64, error) {
+	n := len(B)
+	if n == 0 || len(B[0]) != n {
+		return nil, fmt.Errorf("матрица должна быть квадратной")
+	}
+	if !IsSymmetric(B) {
+		return nil, fmt.Errorf("матрица не симметрическая")
+	}
+
+	L := make([][]float64, n)
+	for i := range L {
+		L[i] = make([]float64, n)
+	}
+
+	for j := 0; j < n; j++ {
+		sum := B[j][j]
+		for k := 0; k < j; k++ {
+			sum -= L[j][k] * L[j][k]
+		}
+		if sum <= 0 {
+			return nil, fmt.Errorf("матрица не является положительно определённой (шаг %d)", j+1)
+		}
+		L[j][j] = math.Sqrt(sum)
+
+		for i := j + 1; i < n; i++ {
+			sum := B[i][j]
+			for k := 0; k < j; k++ {
+				sum -= L[i][k] * L[j][k]
+			}
+			L[i][j] = sum / L[j][j]
+		}
+	}
+
+	return L, nil
+}
+
+// GeneralizedJacobi решает задачу A·x = λ·B·x для симметричной A и симметричной
+// положительно определённой B. Задача приводится к стандартному виду
+// C = L⁻¹·A·L⁻ᵀ через разложение Холецкого B = L·Lᵀ, после чего C
+// диагонализуется вращениями Якоби. Собственные векторы X = L⁻ᵀ·Y
+// B-ортонормированы: Xᵀ·B·X = I.
+func GeneralizedJacobi(A, B [][]float64, epsilon float64) ([]float64, [][]float64, []float64, int, error) {
+	n := len(A)
+	if n == 0 || len(B) != n {
+		return nil, nil, nil, 0, fmt.Errorf("матрицы A и B должны быть одного размера")
+	}
+	for i := 0; i < n; i++ {
+		if len(A[i]) != n || len(B[i]) != n {
+			return nil, nil, nil, 0, fmt.Errorf("матрицы A и B должны быть квадратными")
+		}
+	}
+	if !IsSymmetric(A) {
+		return nil, nil, nil, 0, fmt.Errorf("матрица A не симметрическая")
+	}
+
+	L, err := Cholesky(B)
+	if err != nil {
+		return nil, nil, nil, 0, fmt.Errorf("разложение Холецкого матрицы B: %w", err)
+	}
+
+	// W = L⁻¹·A, затем C = W·L⁻ᵀ = (L⁻¹·Wᵀ)ᵀ
+	W := make([][]float64, n)
+	for i := range W {
+		W[i] = make([]float64, n)
+	}
+	for j := 0; j < n; j++ {
+		col := make([]float64, n)
+		for i := 0; i < n; i++ {
+			col[i] = A[i][j]
+		}
+		y := forwardSubstitution(L, col)
+		for i := 0; i < n; i++ {
+			W[i][j] = y[i]
+		}
+	}
+
+	C := make([][]float64, n)
+	for i := range C {
+		C[i] = forwardSubstitution(L, W[i])
+	}
+	for i := 0; i < n; i++ {
+		for j := i + 1; j < n; j++ {
+			avg := (C[i][j] + C[j][i]) / 2
+			C[i][j], C[j][i] = avg, avg
+		}
+	}
+
+	eigenvalues, Y, iterErrors, iterations := JacobiRotation(C, epsilon)
+
+	// X = L⁻ᵀ·Y
+	X := make([][]float64, n)
+	for i := range X {
+		X[i] = make([]float64, n)
+	}
+	for k := 0; k < n; k++ {
+		col := make([]float64, n)
+		for i := 0; i < n; i++ {
+			col[i] = Y[i][k]
+		}
+		x := backSubstitutionTransposed(L, col)
+		for i := 0; i < n; i++ {
+			X[i][k] = x[i]
+		}
+	}
+
+	return eigenvalues, X, iterErrors, iterations, nil
+}
+
+// Lz = b
+func forwardSubstitution(L [][]float64, b []float64) []float64 {
+	n := len(b)
+	z := make([]float64, n)
+	for i := 0; i < n; i++ {
+		sum := b[i]
+		for j := 0; j < i; j++ {
+			sum -= L[i][j] * z[j]
+		}
+		z[i] = sum / L[i][i]
+	}
+	return z
+}
+
+// Lᵀx = b
+func backSubstitutionTransposed(L [][]float64, b []float64) []float64 {
+	n := len(b)
+	x := make([]float64, n)
+	for i := n - 1; i >= 0; i-- {
+		sum := b[i]
+		for j := i + 1; j < n; j++ {
+			sum -= L[j][i] * x[j]
+		}
+		x[i] = sum / L[i][i]
+	}
+	return x
+}
+
+// VerifyGeneralized возвращает для каждой пары максимальную компоненту
+// |A*x - λ*B*x| и отклонение ‖Xᵀ·B·X − I‖ (максимум модуля элемента).
+func VerifyGeneralized(A, B [][]float64, eigenvalues []float64, eigenvectors [][]float64) ([]float64, float64) {
+	n := len(A)
+	errors := make([]float64, n)
+
+	for k := 0; k < n; k++ {
+		maxError := 0.0
+		for i := 0; i < n; i++ {
+			ax, bx := 0.0, 0.0
+			for j := 0; j < n; j++ {
+				ax += A[i][j] * eigenvectors[j][k]
+				bx += B[i][j] * eigenvectors[j][k]
+			}
+			error := math.Abs(ax - eigenvalues[k]*bx)
+			if error > maxError {
+				maxError = error
+			}
+		}
+		errors[k] = maxError
+	}
+
+	defect := 0.0
+	for p := 0; p < n; p++ {
+		for q := 0; q < n; q++ {
+			sum := 0.0
+			for i := 0; i < n; i++ {
+				for j := 0; j < n; j++ {
+					sum += eigenvectors[i][p] * B[i][j] * eigenvectors[j][q]
+				}
+			}
+			if p == q {
+				sum -= 1
+			}
+			defect = math.Max(defect, math.Abs(sum))
+		}
+	}
+
+	return errors, defect
+}
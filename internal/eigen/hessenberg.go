@@ -0,0 +1,249 @@
+package eigen
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/KaiserRed/numeric_methods/internal/svd_decompose"
+)
+
+// Общее число QR-шагов ограничено hessenbergIterationFactor·max(10, n),
+// как в LAPACK: кратным комплексным парам нужно больше 30 шагов на значение.
+const hessenbergIterationFactor = 30
+
+// HessenbergQR находит собственные значения верхней хессенберговой матрицы
+// QR-алгоритмом Фрэнсиса с двойным неявным сдвигом. Матрица предварительно
+// балансируется, поддиагональные элементы, малые относительно соседних
+// диагональных, отсекаются. Если собственные значения не отделились за
+// отведённое число шагов, возвращается ошибка.
+func HessenbergQR(H [][]float64) ([]complex128, int, error) {
+	n := len(H)
+	for i, row := range H {
+		if len(row) != n {
+			return nil, 0, fmt.Errorf("матрица должна быть квадратной")
+		}
+		for j := 0; j < i-1; j++ {
+			if row[j] != 0 {
+				return nil, 0, fmt.Errorf("матрица не хессенбергова: элемент (%d, %d) = %g", i, j, row[j])
+			}
+		}
+	}
+
+	a := copyMatrix(H)
+	balance(a)
+
+	const eps = svd_decompose.MachineEpsilon
+	anorm := 0.0
+	for i := 0; i < n; i++ {
+		for j := max(i-1, 0); j < n; j++ {
+			anorm += math.Abs(a[i][j])
+		}
+	}
+
+	eigenvalues := make([]complex128, n)
+	maxIter := hessenbergIterationFactor * max(10, n)
+	total := 0
+	nn := n - 1
+	t := 0.0 // накопленный исключительный сдвиг
+	for nn >= 0 {
+		its := 0
+		var l int
+		for {
+			// Поиск малого поддиагонального элемента
+			for l = nn; l > 0; l-- {
+				s := math.Abs(a[l-1][l-1]) + math.Abs(a[l][l])
+				if s == 0 {
+					s = anorm
+				}
+				if math.Abs(a[l][l-1]) <= eps*s {
+					a[l][l-1] = 0
+					break
+				}
+			}
+
+			x := a[nn][nn]
+			if l == nn {
+				eigenvalues[nn] = complex(x+t, 0)
+				nn--
+				break
+			}
+			y := a[nn-1][nn-1]
+			w := a[nn][nn-1] * a[nn-1][nn]
+			if l == nn-1 {
+				// Отделился блок 2×2
+				p := 0.5 * (y - x)
+				q := p*p + w
+				z := math.Sqrt(math.Abs(q))
+				x += t
+				if q >= 0 {
+					z = p + math.Copysign(z, p)
+					eigenvalues[nn-1] = complex(x+z, 0)
+					eigenvalues[nn] = eigenvalues[nn-1]
+					if z != 0 {
+						eigenvalues[nn] = complex(x-w/z, 0)
+					}
+				} else {
+					eigenvalues[nn-1] = complex(x+p, z)
+					eigenvalues[nn] = complex(x+p, -z)
+				}
+				nn -= 2
+				break
+			}
+
+			if total == maxIter {
+				return nil, total, fmt.Errorf("QR-алгоритм не сошёлся за %d шагов: не отделились собственные значения 1–%d", total, nn+1)
+			}
+			if its > 0 && its%10 == 0 {
+				// Исключительный сдвиг, чтобы выйти из цикла: по нижнему
+				// или (каждый второй раз) по верхнему краю активного блока
+				t += x
+				for i := 0; i <= nn; i++ {
+					a[i][i] -= x
+				}
+				s := math.Abs(a[nn][nn-1]) + math.Abs(a[nn-1][nn-2])
+				if its%20 == 10 {
+					s = math.Abs(a[l+1][l]) + math.Abs(a[l+2][l+1])
+					x = 0.75*s + a[l][l]
+				} else {
+					x = 0.75 * s
+				}
+				y = x
+				w = -0.4375 * s * s
+			}
+			its++
+			total++
+			francisStep(a, l, nn, x, y, w, eps)
+		}
+	}
+	return eigenvalues, total, nil
+}
+
+// francisStep выполняет двойной неявный QR-шаг на активном блоке
+// a[l..nn][l..nn]; сдвиги — собственные значения нижнего блока 2×2
+// (x, y — его диагональ, w — произведение внедиагональных элементов).
+func francisStep(a [][]float64, l, nn int, x, y, w, eps float64) {
+	var m int
+	var p, q, r, z float64
+	// Начало «горба»: два последовательных малых поддиагональных элемента
+	for m = nn - 2; m >= l; m-- {
+		z = a[m][m]
+		r = x - z
+		s := y - z
+		p = (r*s-w)/a[m+1][m] + a[m][m+1]
+		q = a[m+1][m+1] - z - r - s
+		r = a[m+2][m+1]
+		s = math.Abs(p) + math.Abs(q) + math.Abs(r)
+		p /= s
+		q /= s
+		r /= s
+		if m == l {
+			break
+		}
+		u := math.Abs(a[m][m-1]) * (math.Abs(q) + math.Abs(r))
+		v := math.Abs(p) * (math.Abs(a[m-1][m-1]) + math.Abs(z) + math.Abs(a[m+1][m+1]))
+		if u <= eps*v {
+			break
+		}
+	}
+	for i := m; i < nn-1; i++ {
+		a[i+2][i] = 0
+		if i != m {
+			a[i+2][i-1] = 0
+		}
+	}
+
+	// Прогонка «горба» отражениями Хаусхолдера 3×3
+	for k := m; k < nn; k++ {
+		if k != m {
+			p = a[k][k-1]
+			q = a[k+1][k-1]
+			r = 0
+			if k+1 != nn {
+				r = a[k+2][k-1]
+			}
+			if x = math.Abs(p) + math.Abs(q) + math.Abs(r); x != 0 {
+				p /= x
+				q /= x
+				r /= x
+			}
+		}
+		s := math.Copysign(math.Sqrt(p*p+q*q+r*r), p)
+		if s == 0 {
+			continue
+		}
+		if k == m {
+			if l != m {
+				a[k][k-1] = -a[k][k-1]
+			}
+		} else {
+			a[k][k-1] = -s * x
+		}
+		p += s
+		x = p / s
+		y := q / s
+		z = r / s
+		q /= p
+		r /= p
+		for j := k; j <= nn; j++ {
+			p = a[k][j] + q*a[k+1][j]
+			if k+1 != nn {
+				p += r * a[k+2][j]
+				a[k+2][j] -= p * z
+			}
+			a[k+1][j] -= p * y
+			a[k][j] -= p * x
+		}
+		for i := l; i <= min(nn, k+3); i++ {
+			p = x*a[i][k] + y*a[i][k+1]
+			if k+1 != nn {
+				p += z * a[i][k+2]
+				a[i][k+2] -= p * r
+			}
+			a[i][k+1] -= p * q
+			a[i][k] -= p
+		}
+	}
+}
+
+// balance выравнивает нормы строк и столбцов диагональным подобием
+// D⁻¹·A·D со степенями двойки в D (собственные значения не меняются,
+// хессенбергова форма сохраняется). Для сопровождающих матриц с
+// коэффициентами разного порядка это заметно повышает точность.
+func balance(a [][]float64) {
+	const radix = 2.0
+	n := len(a)
+	for done := false; !done; {
+		done = true
+		for i := 0; i < n; i++ {
+			r, c := 0.0, 0.0
+			for j := 0; j < n; j++ {
+				if j != i {
+					c += math.Abs(a[j][i])
+					r += math.Abs(a[i][j])
+				}
+			}
+			if c == 0 || r == 0 {
+				continue
+			}
+			g := r / radix
+			f := 1.0
+			s := c + r
+			for c < g {
+				f *= radix
+				c *= radix * radix
+			}
+			g = r * radix
+			for c > g {
+				f /= radix
+				c /= radix * radix
+			}
+			if (c+r)/f < 0.95*s {
+				done = false
+				for j := 0; j < n; j++ {
+					a[i][j] /= f
+					a[j][i] *= f
+				}
+			}
+		}
+	}
+}
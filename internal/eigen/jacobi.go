@@ -0,0 +1,132 @@
+package eigen
+
+import (
+	"math"
+)
+
+const maxJacobiIterations = 1000
+
+func IsSymmetric(A [][]float64) bool {
+	n := len(A)
+	for i := 0; i < n; i++ {
+		for j := 0; j < n; j++ {
+			if math.Abs(A[i][j]-A[j][i]) > 1e-6 {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// JacobiRotation находит собственные значения и собственные векторы
+// (по столбцам) симметричной матрицы методом вращений Якоби.
+func JacobiRotation(A [][]float64, epsilon float64) ([]float64, [][]float64, []float64, int) {
+	n := len(A)
+	V := make([][]float64, n)
+	for i := range V {
+		V[i] = make([]float64, n)
+		V[i][i] = 1.0
+	}
+
+	iterations := 0
+	maxOffDiag := maxOffDiagonal(A)
+	errors := []float64{maxOffDiag}
+
+	for maxOffDiag > epsilon && iterations < maxJacobiIterations {
+		p, q := findMaxOffDiagonal(A)
+		phi := 0.5 * math.Atan2(2*A[p][q], A[q][q]-A[p][p])
+		c := math.Cos(phi)
+		s := math.Sin(phi)
+
+		newA := make([][]float64, n)
+		for i := range newA {
+			newA[i] = make([]float64, n)
+			copy(newA[i], A[i])
+		}
+
+		for i := 0; i < n; i++ {
+			if i != p && i != q {
+				newA[i][p] = c*A[i][p] - s*A[i][q]
+				newA[p][i] = newA[i][p]
+				newA[i][q] = s*A[i][p] + c*A[i][q]
+				newA[q][i] = newA[i][q]
+			}
+		}
+
+		newA[p][p] = c*c*A[p][p] - 2*c*s*A[p][q] + s*s*A[q][q]
+		newA[q][q] = s*s*A[p][p] + 2*c*s*A[p][q] + c*c*A[q][q]
+		newA[p][q] = 0
+		newA[q][p] = 0
+
+		A = newA
+
+		for i := 0; i < n; i++ {
+			vip := V[i][p]
+			viq := V[i][q]
+			V[i][p] = c*vip - s*viq
+			V[i][q] = s*vip + c*viq
+		}
+
+		maxOffDiag = maxOffDiagonal(A)
+		errors = append(errors, maxOffDiag)
+		iterations++
+	}
+
+	eigenvalues := make([]float64, n)
+	for i := 0; i < n; i++ {
+		eigenvalues[i] = A[i][i]
+	}
+
+	return eigenvalues, V, errors, iterations
+}
+
+func maxOffDiagonal(A [][]float64) float64 {
+	max := 0.0
+	n := len(A)
+	for i := 0; i < n; i++ {
+		for j := i + 1; j < n; j++ {
+			if val := math.Abs(A[i][j]); val > max {
+				max = val
+			}
+		}
+	}
+	return max
+}
+
+func findMaxOffDiagonal(A [][]float64) (int, int) {
+	max := 0.0
+	p, q := 0, 0
+	n := len(A)
+	for i := 0; i < n; i++ {
+		for j := i + 1; j < n; j++ {
+			if val := math.Abs(A[i][j]); val > max {
+				max = val
+				p, q = i, j
+			}
+		}
+	}
+	return p, q
+}
+
+// VerifyEigen возвращает для каждой пары максимальную компоненту |A*v - λ*v|.
+func VerifyEigen(A [][]float64, eigenvalues []float64, eigenvectors [][]float64) []float64 {
+	n := len(A)
+	errors := make([]float64, n)
+
+	for k := 0; k < n; k++ {
+		maxError := 0.0
+		for i := 0; i < n; i++ {
+			sum := 0.0
+			for j := 0; j < n; j++ {
+				sum += A[i][j] * eigenvectors[j][k]
+			}
+			error := math.Abs(sum - eigenvalues[k]*eigenvectors[i][k])
+			if error > maxError {
+				maxError = error
+			}
+		}
+		errors[k] = maxError
+	}
+
+	return errors
+}
@@ -0,0 +1,94 @@
+package eigen
+
+import (
+	"fmt"
+	"math"
+)
+
+// Eigenvalues находит собственные значения произвольной квадратной матрицы:
+// матрица приводится к хессенберговой форме отражениями Хаусхолдера, после
+// чего применяется QR-алгоритм со сдвигами HessenbergQR. Возвращается и
+// число QR-шагов.
+func Eigenvalues(A [][]float64) ([]complex128, int, error) {
+	n := len(A)
+	if n == 0 {
+		return nil, 0, fmt.Errorf("матрица пуста")
+	}
+	for _, row := range A {
+		if len(row) != n {
+			return nil, 0, fmt.Errorf("матрица должна быть квадратной")
+		}
+	}
+	H := copyMatrix(A)
+	toHessenberg(H)
+	return HessenbergQR(H)
+}
+
+// toHessenberg приводит a на месте к верхней хессенберговой форме
+// подобием a ← P·a·P, P = I − β·v·vᵀ, по одному отражению на столбец.
+func toHessenberg(a [][]float64) {
+	n := len(a)
+	for k := 0; k < n-2; k++ {
+		norm := 0.0
+		for i := k + 1; i < n; i++ {
+			norm = math.Hypot(norm, a[i][k])
+		}
+		if norm == 0 {
+			continue
+		}
+		alpha := -math.Copysign(norm, a[k+1][k])
+		v := make([]float64, n-k-1)
+		for i := range v {
+			v[i] = a[k+1+i][k]
+		}
+		v[0] -= alpha
+		vv := 0.0
+		for _, x := range v {
+			vv += x * x
+		}
+		if vv == 0 {
+			continue
+		}
+		beta := 2 / vv
+
+		// Отражение строк k+1..n−1
+		for j := k; j < n; j++ {
+			s := 0.0
+			for i, x := range v {
+				s += x * a[k+1+i][j]
+			}
+			s *= beta
+			for i, x := range v {
+				a[k+1+i][j] -= s * x
+			}
+		}
+		// Отражение столбцов k+1..n−1
+		for i := 0; i < n; i++ {
+			s := 0.0
+			for j, x := range v {
+				s += a[i][k+1+j] * x
+			}
+			s *= beta
+			for j, x := range v {
+				a[i][k+1+j] -= s * x
+			}
+		}
+
+		a[k+1][k] = alpha
+		for i := k + 2; i < n; i++ {
+			a[i][k] = 0
+		}
+	}
+}
+
+func copyMatrix(A [][]float64) [][]float64 {
+	n := len(A)
+	copy := make([][]float64, n)
+	for i := range copy {
+		copy[i] = make([]float64, n)
+		for j := 0; j < n; j++ {
+			copy[i][j] = A[i][j]
+		}
+	}
+	return copy
+}
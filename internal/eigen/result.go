@@ -0,0 +1,139 @@
+package eigen
+
+import (
+	"math"
+	"sort"
+)
+
+type SortOrder int
+
+const (
+	Unsorted SortOrder = iota
+	Ascending
+	Descending
+	ByMagnitude // по убыванию |λ|
+)
+
+// EigenResult хранит собственные пары; Vectors[i][k] — i-я компонента k-го вектора.
+type EigenResult struct {
+	Values     []float64
+	Vectors    [][]float64
+	IterErrors []float64
+	Iterations int
+}
+
+// Jacobi выполняет метод вращений Якоби и приводит результат к каноническому
+// виду: векторы нормированы, знак выбран детерминированно, пары упорядочены.
+func Jacobi(A [][]float64, epsilon float64, order SortOrder) EigenResult {
+	values, vectors, iterErrors, iterations := JacobiRotation(A, epsilon)
+	r := EigenResult{
+		Values:     values,
+		Vectors:    vectors,
+		IterErrors: iterErrors,
+		Iterations: iterations,
+	}
+	r.Normalize()
+	r.Sort(order)
+	return r
+}
+
+// Sort упорядочивает собственные пары, переставляя столбцы Vectors вместе со значениями.
+func (r *EigenResult) Sort(order SortOrder) {
+	n := len(r.Values)
+	idx := make([]int, n)
+	for i := range idx {
+		idx[i] = i
+	}
+
+	var less func(a, b float64) bool
+	switch order {
+	case Ascending:
+		less = func(a, b float64) bool { return a < b }
+	case Descending:
+		less = func(a, b float64) bool { return a > b }
+	case ByMagnitude:
+		less = func(a, b float64) bool { return math.Abs(a) > math.Abs(b) }
+	default:
+		return
+	}
+	sort.SliceStable(idx, func(a, b int) bool { return less(r.Values[idx[a]], r.Values[idx[b]]) })
+
+	values := make([]float64, n)
+	vectors := make([][]float64, len(r.Vectors))
+	for i := range vectors {
+		vectors[i] = make([]float64, n)
+	}
+	for k, j := range idx {
+		values[k] = r.Values[j]
+		for i := range vectors {
+			vectors[i][k] = r.Vectors[i][j]
+		}
+	}
+	r.Values = values
+	r.Vectors = vectors
+}
+
+// Normalize приводит каждый вектор к единичной евклидовой норме и выбирает знак
+// так, чтобы наибольшая по модулю компонента (первая при равенстве) была положительной.
+func (r *EigenResult) Normalize() {
+	rows := len(r.Vectors)
+	for k := range r.Values {
+		norm := 0.0
+		pivot := 0
+		for i := 0; i < rows; i++ {
+			v := r.Vectors[i][k]
+			norm += v * v
+			// Равенство модулей — с относительным допуском, не зависящим от
+			// масштаба вектора
+			a, p := math.Abs(v), math.Abs(r.Vectors[pivot][k])
+			if a-p > 1e-12*math.Max(a, p) {
+				pivot = i
+			}
+		}
+		norm = math.Sqrt(norm)
+		if norm == 0 {
+			continue
+		}
+		if r.Vectors[pivot][k] < 0 {
+			norm = -norm
+		}
+		for i := 0; i < rows; i++ {
+			r.Vectors[i][k] /= norm
+		}
+	}
+}
+
+// OrthogonalityDefect возвращает ‖VᵀV − I‖ в норме Фробениуса.
+func (r *EigenResult) OrthogonalityDefect() float64 {
+	n := len(r.Values)
+	sum := 0.0
+	for p := 0; p < n; p++ {
+		for q := 0; q < n; q++ {
+			dot := 0.0
+			for i := range r.Vectors {
+				dot += r.Vectors[i][p] * r.Vectors[i][q]
+			}
+			if p == q {
+				dot -= 1
+			}
+			sum += dot * dot
+		}
+	}
+	return math.Sqrt(sum)
+}
+
+// ReconstructionError возвращает ‖A − VΛVᵀ‖ в норме Фробениуса.
+func (r *EigenResult) ReconstructionError(A [][]float64) float64 {
+	n := len(A)
+	sum := 0.0
+	for i := 0; i < n; i++ {
+		for j := 0; j < n; j++ {
+			val := A[i][j]
+			for k, lambda := range r.Values {
+				val -= r.Vectors[i][k] * lambda * r.Vectors[j][k]
+			}
+			sum += val * val
+		}
+	}
+	return math.Sqrt(sum)
+}
@@ -0,0 +1,127 @@
+package expr
+
+import (
+	"strconv"
+	"strings"
+)
+
+// Node — узел дерева выражения.
+type Node interface {
+	String() string
+	precedence() int
+}
+
+type Num struct {
+	Value float64
+}
+
+type Var struct {
+	Name string
+}
+
+// Unary — унарный минус.
+type Unary struct {
+	X Node
+}
+
+// Binary — бинарная операция: '+', '-', '*', '/', '^'.
+type Binary struct {
+	Op   byte
+	L, R Node
+}
+
+type Call struct {
+	Func string
+	Args []Node
+}
+
+const (
+	precAdd = iota + 1
+	precMul
+	precUnary
+	precPow
+	precAtom
+)
+
+func (n Num) precedence() int {
+	if n.Value < 0 {
+		return precUnary
+	}
+	return precAtom
+}
+func (Var) precedence() int   { return precAtom }
+func (Unary) precedence() int { return precUnary }
+func (Call) precedence() int  { return precAtom }
+func (b Binary) precedence() int {
+	switch b.Op {
+	case '+', '-':
+		return precAdd
+	case '*', '/':
+		return precMul
+	default:
+		return precPow
+	}
+}
+
+func (n Num) String() string {
+	return strconv.FormatFloat(n.Value, 'g', -1, 64)
+}
+
+func (v Var) String() string {
+	return v.Name
+}
+
+func (u Unary) String() string {
+	return "-" + wrap(u.X, precUnary, false)
+}
+
+func (b Binary) String() string {
+	p := b.precedence()
+	// '^' правоассоциативна, остальные операции — левоассоциативны
+	leftStrict := b.Op == '^'
+	rightStrict := b.Op != '^' && b.Op != '+' && b.Op != '*'
+	return wrap(b.L, p, leftStrict) + " " + string(b.Op) + " " + wrap(b.R, p, rightStrict)
+}
+
+func (c Call) String() string {
+	args := make([]string, len(c.Args))
+	for i, a := range c.Args {
+		args[i] = a.String()
+	}
+	return c.Func + "(" + strings.Join(args, ", ") + ")"
+}
+
+func wrap(n Node, parent int, strict bool) string {
+	p := n.precedence()
+	if p < parent || (strict && p == parent) {
+		return "(" + n.String() + ")"
+	}
+	return n.String()
+}
+
+// Variables возвращает имена всех переменных выражения в порядке появления.
+func Variables(n Node) []string {
+	seen := map[string]bool{}
+	var names []string
+	var walk func(Node)
+	walk = func(n Node) {
+		switch n := n.(type) {
+		case Var:
+			if !seen[n.Name] {
+				seen[n.Name] = true
+				names = append(names, n.Name)
+			}
+		case Unary:
+			walk(n.X)
+		case Binary:
+			walk(n.L)
+			walk(n.R)
+		case Call:
+			for _, a := range n.Args {
+				walk(a)
+			}
+		}
+	}
+	walk(n)
+	return names
+}
@@ -0,0 +1,102 @@
+package expr
+
+import (
+	"fmt"
+	"math"
+)
+
+// DerivativeCheck описывает наибольшее расхождение между производной
+// и центральной конечной разностью по набору точек.
+type DerivativeCheck struct {
+	Point      []float64
+	Row, Col   int
+	Derivative float64
+	FiniteDiff float64
+	Error      float64 // |Derivative − FiniteDiff| / max(1, |FiniteDiff|)
+	Points     int     // число точек, где обе величины определены
+}
+
+// CheckJacobian сравнивает матрицу производных jac[i][j] = ∂f_i/∂vars_j
+// с центральными разностями в заданных точках.
+func CheckJacobian(fs []Node, jac [][]Node, vars []string, params map[string]float64, points [][]float64) (DerivativeCheck, error) {
+	if len(jac) != len(fs) {
+		return DerivativeCheck{}, fmt.Errorf("число строк якобиана не совпадает с числом функций")
+	}
+
+	fns := make([]func([]float64) float64, len(fs))
+	dfns := make([][]func([]float64) float64, len(fs))
+	for i := range fs {
+		var err error
+		fns[i], err = Compile(fs[i], vars, params)
+		if err != nil {
+			return DerivativeCheck{}, err
+		}
+		if len(jac[i]) != len(vars) {
+			return DerivativeCheck{}, fmt.Errorf("число столбцов якобиана не совпадает с числом переменных")
+		}
+		dfns[i] = make([]func([]float64) float64, len(vars))
+		for j := range vars {
+			dfns[i][j], err = Compile(jac[i][j], vars, params)
+			if err != nil {
+				return DerivativeCheck{}, err
+			}
+		}
+	}
+
+	worst := DerivativeCheck{Error: -1}
+	counted := 0
+	for _, p := range points {
+		if len(p) != len(vars) {
+			return DerivativeCheck{}, fmt.Errorf("размерность точки не совпадает с числом переменных")
+		}
+		valid := false
+		for i := range fs {
+			for j := range vars {
+				d := dfns[i][j](p)
+				fd := CentralDifference(fns[i], p, j)
+				if math.IsNaN(d) || math.IsNaN(fd) || math.IsInf(d, 0) || math.IsInf(fd, 0) {
+					continue
+				}
+				valid = true
+				e := math.Abs(d-fd) / math.Max(1, math.Abs(fd))
+				if e > worst.Error {
+					worst = DerivativeCheck{
+						Point:      append([]float64(nil), p...),
+						Row:        i,
+						Col:        j,
+						Derivative: d,
+						FiniteDiff: fd,
+						Error:      e,
+					}
+				}
+			}
+		}
+		if valid {
+			counted++
+		}
+	}
+	if counted == 0 {
+		return DerivativeCheck{}, fmt.Errorf("функции не определены ни в одной из точек проверки")
+	}
+	worst.Points = counted
+	return worst, nil
+}
+
+// CheckDerivative — частный случай CheckJacobian для функции одной переменной.
+func CheckDerivative(f, df Node, v string, params map[string]float64, points []float64) (DerivativeCheck, error) {
+	pts := make([][]float64, len(points))
+	for i, x := range points {
+		pts[i] = []float64{x}
+	}
+	return CheckJacobian([]Node{f}, [][]Node{{df}}, []string{v}, params, pts)
+}
+
+// CentralDifference вычисляет ∂f/∂x_j центральной разностью с шагом h = ∛ε·max(1, |x_j|).
+func CentralDifference(f func([]float64) float64, x []float64, j int) float64 {
+	h := 6.055454452393343e-06 * math.Max(1, math.Abs(x[j]))
+	xp := append([]float64(nil), x...)
+	xm := append([]float64(nil), x...)
+	xp[j] += h
+	xm[j] -= h
+	return (f(xp) - f(xm)) / (xp[j] - xm[j])
+}
@@ -0,0 +1,211 @@
+package expr
+
+import (
+	"fmt"
+	"math"
+)
+
+type function struct {
+	arity int
+	eval  func(args []float64) float64
+}
+
+var functions = map[string]function{
+	"sin":   {1, func(a []float64) float64 { return math.Sin(a[0]) }},
+	"cos":   {1, func(a []float64) float64 { return math.Cos(a[0]) }},
+	"tan":   {1, func(a []float64) float64 { return math.Tan(a[0]) }},
+	"cot":   {1, func(a []float64) float64 { return 1 / math.Tan(a[0]) }},
+	"asin":  {1, func(a []float64) float64 { return math.Asin(a[0]) }},
+	"acos":  {1, func(a []float64) float64 { return math.Acos(a[0]) }},
+	"atan":  {1, func(a []float64) float64 { return math.Atan(a[0]) }},
+	"sinh":  {1, func(a []float64) float64 { return math.Sinh(a[0]) }},
+	"cosh":  {1, func(a []float64) float64 { return math.Cosh(a[0]) }},
+	"tanh":  {1, func(a []float64) float64 { return math.Tanh(a[0]) }},
+	"exp":   {1, func(a []float64) float64 { return math.Exp(a[0]) }},
+	"ln":    {1, func(a []float64) float64 { return math.Log(a[0]) }},
+	"log":   {1, func(a []float64) float64 { return math.Log(a[0]) }},
+	"log10": {1, func(a []float64) float64 { return math.Log10(a[0]) }},
+	"log2":  {1, func(a []float64) float64 { return math.Log2(a[0]) }},
+	"sqrt":  {1, func(a []float64) float64 { return math.Sqrt(a[0]) }},
+	"cbrt":  {1, func(a []float64) float64 { return math.Cbrt(a[0]) }},
+	"abs":   {1, func(a []float64) float64 { return math.Abs(a[0]) }},
+	"pow":   {2, func(a []float64) float64 { return math.Pow(a[0], a[1]) }},
+	"min":   {2, func(a []float64) float64 { return math.Min(a[0], a[1]) }},
+	"max":   {2, func(a []float64) float64 { return math.Max(a[0], a[1]) }},
+}
+
+var constants = map[string]float64{
+	"pi": math.Pi,
+	"e":  math.E,
+}
+
+// Compile превращает выражение в замыкание от переменных vars (в указанном
+// порядке). Имена, не входящие в vars, ищутся среди params, затем среди
+// констант pi и e. Подвыражения без переменных вычисляются заранее.
+func Compile(n Node, vars []string, params map[string]float64) (func(args []float64) float64, error) {
+	index := make(map[string]int, len(vars))
+	for i, v := range vars {
+		index[v] = i
+	}
+	fn, _, err := compile(n, index, params)
+	return fn, err
+}
+
+// Compile1 компилирует функцию одной переменной.
+func Compile1(n Node, v string, params map[string]float64) (func(float64) float64, error) {
+	fn, err := Compile(n, []string{v}, params)
+	if err != nil {
+		return nil, err
+	}
+	return func(x float64) float64 { return fn([]float64{x}) }, nil
+}
+
+// Compile2 компилирует функцию двух переменных.
+func Compile2(n Node, v1, v2 string, params map[string]float64) (func(float64, float64) float64, error) {
+	fn, err := Compile(n, []string{v1, v2}, params)
+	if err != nil {
+		return nil, err
+	}
+	return func(x, y float64) float64 { return fn([]float64{x, y}) }, nil
+}
+
+// ParseFunc1 разбирает и компилирует функцию одной переменной.
+func ParseFunc1(src, v string, params map[string]float64) (func(float64) float64, error) {
+	n, err := Parse(src)
+	if err != nil {
+		return nil, err
+	}
+	return Compile1(n, v, params)
+}
+
+// ParseFunc2 разбирает и компилирует функцию двух переменных.
+func ParseFunc2(src, v1, v2 string, params map[string]float64) (func(float64, float64) float64, error) {
+	n, err := Parse(src)
+	if err != nil {
+		return nil, err
+	}
+	return Compile2(n, v1, v2, params)
+}
+
+// compile возвращает замыкание и признак того, что оно не зависит от переменных.
+func compile(n Node, index map[string]int, params map[string]float64) (func([]float64) float64, bool, error) {
+	switch n := n.(type) {
+	case Num:
+		v := n.Value
+		return func([]float64) float64 { return v }, true, nil
+
+	case Var:
+		if i, ok := index[n.Name]; ok {
+			return func(args []float64) float64 { return args[i] }, false, nil
+		}
+		if v, ok := params[n.Name]; ok {
+			return func([]float64) float64 { return v }, true, nil
+		}
+		if v, ok := constants[n.Name]; ok {
+			return func([]float64) float64 { return v }, true, nil
+		}
+		return nil, false, fmt.Errorf("неизвестная переменная %q", n.Name)
+
+	case Unary:
+		x, isConst, err := compile(n.X, index, params)
+		if err != nil {
+			return nil, false, err
+		}
+		return fold(func(args []float64) float64 { return -x(args) }, isConst), isConst, nil
+
+	case Binary:
+		l, lConst, err := compile(n.L, index, params)
+		if err != nil {
+			return nil, false, err
+		}
+		r, rConst, err := compile(n.R, index, params)
+		if err != nil {
+			return nil, false, err
+		}
+		var fn func([]float64) float64
+		switch n.Op {
+		case '+':
+			fn = func(args []float64) float64 { return l(args) + r(args) }
+		case '-':
+			fn = func(args []float64) float64 { return l(args) - r(args) }
+		case '*':
+			fn = func(args []float64) float64 { return l(args) * r(args) }
+		case '/':
+			fn = func(args []float64) float64 { return l(args) / r(args) }
+		case '^':
+			fn = compilePow(l, r, rConst)
+		default:
+			return nil, false, fmt.Errorf("неизвестная операция %q", n.Op)
+		}
+		isConst := lConst && rConst
+		return fold(fn, isConst), isConst, nil
+
+	case Call:
+		fn, ok := functions[n.Func]
+		if !ok {
+			return nil, false, fmt.Errorf("неизвестная функция %q", n.Func)
+		}
+		if len(n.Args) != fn.arity {
+			return nil, false, fmt.Errorf("функция %s принимает %d аргумент(а)", n.Func, fn.arity)
+		}
+		args := make([]func([]float64) float64, len(n.Args))
+		isConst := true
+		for i, a := range n.Args {
+			c, argConst, err := compile(a, index, params)
+			if err != nil {
+				return nil, false, err
+			}
+			args[i] = c
+			isConst = isConst && argConst
+		}
+		eval := fn.eval
+		var call func([]float64) float64
+		if len(args) == 1 {
+			arg := args[0]
+			call = func(x []float64) float64 {
+				var buf [1]float64
+				buf[0] = arg(x)
+				return eval(buf[:])
+			}
+		} else {
+			call = func(x []float64) float64 {
+				var buf [2]float64
+				for i, a := range args {
+					buf[i] = a(x)
+				}
+				return eval(buf[:len(args)])
+			}
+		}
+		return fold(call, isConst), isConst, nil
+	}
+	return nil, false, fmt.Errorf("неизвестный тип узла %T", n)
+}
+
+// Целые показатели степени вычисляются умножением, остальные — через math.Pow.
+func compilePow(l, r func([]float64) float64, rConst bool) func([]float64) float64 {
+	if rConst {
+		p := r(nil)
+		switch p {
+		case 1:
+			return l
+		case 2:
+			return func(args []float64) float64 { v := l(args); return v * v }
+		case 3:
+			return func(args []float64) float64 { v := l(args); return v * v * v }
+		case 4:
+			return func(args []float64) float64 { v := l(args); v *= v; return v * v }
+		case 0.5:
+			return func(args []float64) float64 { return math.Sqrt(l(args)) }
+		}
+		return func(args []float64) float64 { return math.Pow(l(args), p) }
+	}
+	return func(args []float64) float64 { return math.Pow(l(args), r(args)) }
+}
+
+func fold(fn func([]float64) float64, isConst bool) func([]float64) float64 {
+	if !isConst {
+		return fn
+	}
+	v := fn(nil)
+	return func([]float64) float64 { return v }
+}
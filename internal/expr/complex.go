@@ -0,0 +1,150 @@
+package expr
+
+import (
+	"fmt"
+	"math"
+	"math/cmplx"
+)
+
+var complexFunctions = map[string]func(complex128) complex128{
+	"sin":   cmplx.Sin,
+	"cos":   cmplx.Cos,
+	"tan":   cmplx.Tan,
+	"cot":   cmplx.Cot,
+	"asin":  cmplx.Asin,
+	"acos":  cmplx.Acos,
+	"atan":  cmplx.Atan,
+	"sinh":  cmplx.Sinh,
+	"cosh":  cmplx.Cosh,
+	"tanh":  cmplx.Tanh,
+	"exp":   cmplx.Exp,
+	"ln":    cmplx.Log,
+	"log":   cmplx.Log,
+	"log10": cmplx.Log10,
+	"log2":  func(z complex128) complex128 { return cmplx.Log(z) / math.Ln2 },
+	"sqrt":  cmplx.Sqrt,
+	"cbrt":  func(z complex128) complex128 { return cmplx.Pow(z, 1.0/3) },
+	"abs":   func(z complex128) complex128 { return complex(cmplx.Abs(z), 0) },
+}
+
+// CompileComplex компилирует выражение в функцию комплексных переменных.
+// Имя i, если оно не занято переменной или параметром, обозначает мнимую
+// единицу. Функции без комплексного продолжения (min, max) не допускаются.
+func CompileComplex(n Node, vars []string, params map[string]float64) (func([]complex128) complex128, error) {
+	index := make(map[string]int, len(vars))
+	for i, v := range vars {
+		index[v] = i
+	}
+	return compileComplex(n, index, params)
+}
+
+// CompileComplex1 компилирует комплексную функцию одной переменной.
+func CompileComplex1(n Node, v string, params map[string]float64) (func(complex128) complex128, error) {
+	fn, err := CompileComplex(n, []string{v}, params)
+	if err != nil {
+		return nil, err
+	}
+	return func(z complex128) complex128 { return fn([]complex128{z}) }, nil
+}
+
+func compileComplex(n Node, index map[string]int, params map[string]float64) (func([]complex128) complex128, error) {
+	switch n := n.(type) {
+	case Num:
+		c := complex(n.Value, 0)
+		return func([]complex128) complex128 { return c }, nil
+
+	case Var:
+		if i, ok := index[n.Name]; ok {
+			return func(args []complex128) complex128 { return args[i] }, nil
+		}
+		v, ok := params[n.Name]
+		if !ok {
+			v, ok = constants[n.Name]
+		}
+		if !ok {
+			if n.Name == "i" {
+				return func([]complex128) complex128 { return 1i }, nil
+			}
+			return nil, fmt.Errorf("неизвестная переменная %q", n.Name)
+		}
+		c := complex(v, 0)
+		return func([]complex128) complex128 { return c }, nil
+
+	case Unary:
+		x, err := compileComplex(n.X, index, params)
+		if err != nil {
+			return nil, err
+		}
+		return func(args []complex128) complex128 { return -x(args) }, nil
+
+	case Binary:
+		l, err := compileComplex(n.L, index, params)
+		if err != nil {
+			return nil, err
+		}
+		r, err := compileComplex(n.R, index, params)
+		if err != nil {
+			return nil, err
+		}
+		switch n.Op {
+		case '+':
+			return func(args []complex128) complex128 { return l(args) + r(args) }, nil
+		case '-':
+			return func(args []complex128) complex128 { return l(args) - r(args) }, nil
+		case '*':
+			return func(args []complex128) complex128 { return l(args) * r(args) }, nil
+		case '/':
+			return func(args []complex128) complex128 { return l(args) / r(args) }, nil
+		case '^':
+			if num, ok := n.R.(Num); ok {
+				return complexPowConst(l, num.Value), nil
+			}
+			return func(args []complex128) complex128 { return cmplx.Pow(l(args), r(args)) }, nil
+		}
+		return nil, fmt.Errorf("неизвестная операция %q", n.Op)
+
+	case Call:
+		args := make([]func([]complex128) complex128, len(n.Args))
+		for i, a := range n.Args {
+			c, err := compileComplex(a, index, params)
+			if err != nil {
+				return nil, err
+			}
+			args[i] = c
+		}
+		if n.Func == "pow" && len(args) == 2 {
+			return func(x []complex128) complex128 { return cmplx.Pow(args[0](x), args[1](x)) }, nil
+		}
+		fn, ok := complexFunctions[n.Func]
+		if !ok || len(args) != 1 {
+			return nil, fmt.Errorf("функция %q не определена для комплексных чисел", n.Func)
+		}
+		arg := args[0]
+		return func(x []complex128) complex128 { return fn(arg(x)) }, nil
+	}
+	return nil, fmt.Errorf("неизвестный тип узла %T", n)
+}
+
+// Целые показатели степени вычисляются умножением: cmplx.Pow идёт через
+// логарифм и вносит погрешность даже для z².
+func complexPowConst(l func([]complex128) complex128, p float64) func([]complex128) complex128 {
+	if p != math.Trunc(p) || math.Abs(p) > 64 {
+		c := complex(p, 0)
+		return func(args []complex128) complex128 { return cmplx.Pow(l(args), c) }
+	}
+	k := int(math.Abs(p))
+	inverse := p < 0
+	return func(args []complex128) complex128 {
+		base, result := l(args), complex(1, 0)
+		for e := k; e > 0; e >>= 1 {
+			if e&1 == 1 {
+				result *= base
+			}
+			base *= base
+		}
+		if inverse {
+			return 1 / result
+		}
+		return result
+	}
+}
@@ -0,0 +1,169 @@
+package expr
+
+import (
+	"fmt"
+	"math"
+)
+
+// Diff возвращает упрощённую производную выражения по переменной v.
+// Все прочие имена (параметры, константы) считаются постоянными.
+func Diff(n Node, v string) (Node, error) {
+	d, err := diff(n, v)
+	if err != nil {
+		return nil, err
+	}
+	return Simplify(d), nil
+}
+
+// Jacobian возвращает матрицу ∂f_i/∂vars_j.
+func Jacobian(fs []Node, vars []string) ([][]Node, error) {
+	J := make([][]Node, len(fs))
+	for i, f := range fs {
+		J[i] = make([]Node, len(vars))
+		for j, v := range vars {
+			d, err := Diff(f, v)
+			if err != nil {
+				return nil, fmt.Errorf("∂f%d/∂%s: %w", i+1, v, err)
+			}
+			J[i][j] = d
+		}
+	}
+	return J, nil
+}
+
+func dependsOn(n Node, v string) bool {
+	for _, name := range Variables(n) {
+		if name == v {
+			return true
+		}
+	}
+	return false
+}
+
+func num(v float64) Node { return Num{Value: v} }
+
+func add(l, r Node) Node { return Binary{Op: '+', L: l, R: r} }
+func sub(l, r Node) Node { return Binary{Op: '-', L: l, R: r} }
+func mul(l, r Node) Node { return Binary{Op: '*', L: l, R: r} }
+func div(l, r Node) Node { return Binary{Op: '/', L: l, R: r} }
+func pow(l, r Node) Node { return Binary{Op: '^', L: l, R: r} }
+func neg(x Node) Node    { return Unary{X: x} }
+func call(name string, args ...Node) Node {
+	return Call{Func: name, Args: args}
+}
+
+func diff(n Node, v string) (Node, error) {
+	if !dependsOn(n, v) {
+		return num(0), nil
+	}
+
+	switch n := n.(type) {
+	case Var:
+		return num(1), nil
+
+	case Unary:
+		dx, err := diff(n.X, v)
+		if err != nil {
+			return nil, err
+		}
+		return neg(dx), nil
+
+	case Binary:
+		dl, err := diff(n.L, v)
+		if err != nil {
+			return nil, err
+		}
+		dr, err := diff(n.R, v)
+		if err != nil {
+			return nil, err
+		}
+		switch n.Op {
+		case '+':
+			return add(dl, dr), nil
+		case '-':
+			return sub(dl, dr), nil
+		case '*':
+			return add(mul(dl, n.R), mul(n.L, dr)), nil
+		case '/':
+			return div(sub(mul(dl, n.R), mul(n.L, dr)), pow(n.R, num(2))), nil
+		case '^':
+			return diffPow(n.L, n.R, dl, dr, v), nil
+		}
+		return nil, fmt.Errorf("неизвестная операция %q", n.Op)
+
+	case Call:
+		if n.Func == "pow" {
+			dl, err := diff(n.Args[0], v)
+			if err != nil {
+				return nil, err
+			}
+			dr, err := diff(n.Args[1], v)
+			if err != nil {
+				return nil, err
+			}
+			return diffPow(n.Args[0], n.Args[1], dl, dr, v), nil
+		}
+		if len(n.Args) != 1 {
+			return nil, fmt.Errorf("функция %s не дифференцируема символьно", n.Func)
+		}
+		u := n.Args[0]
+		du, err := diff(u, v)
+		if err != nil {
+			return nil, err
+		}
+		var outer Node
+		switch n.Func {
+		case "sin":
+			outer = call("cos", u)
+		case "cos":
+			outer = neg(call("sin", u))
+		case "tan":
+			outer = div(num(1), pow(call("cos", u), num(2)))
+		case "cot":
+			outer = neg(div(num(1), pow(call("sin", u), num(2))))
+		case "asin":
+			outer = div(num(1), call("sqrt", sub(num(1), pow(u, num(2)))))
+		case "acos":
+			outer = neg(div(num(1), call("sqrt", sub(num(1), pow(u, num(2))))))
+		case "atan":
+			outer = div(num(1), add(num(1), pow(u, num(2))))
+		case "sinh":
+			outer = call("cosh", u)
+		case "cosh":
+			outer = call("sinh", u)
+		case "tanh":
+			outer = div(num(1), pow(call("cosh", u), num(2)))
+		case "exp":
+			outer = call("exp", u)
+		case "ln", "log":
+			outer = div(num(1), u)
+		case "log10":
+			outer = div(num(1), mul(u, num(math.Ln10)))
+		case "log2":
+			outer = div(num(1), mul(u, num(math.Ln2)))
+		case "sqrt":
+			outer = div(num(1), mul(num(2), call("sqrt", u)))
+		case "cbrt":
+			outer = div(num(1), mul(num(3), pow(call("cbrt", u), num(2))))
+		case "abs":
+			outer = div(u, call("abs", u))
+		default:
+			return nil, fmt.Errorf("функция %s не дифференцируема символьно", n.Func)
+		}
+		return mul(outer, du), nil
+	}
+	return nil, fmt.Errorf("неизвестный тип узла %T", n)
+}
+
+func diffPow(base, exp, dBase, dExp Node, v string) Node {
+	if !dependsOn(exp, v) {
+		// (u^c)' = c·u^(c-1)·u'
+		return mul(mul(exp, pow(base, sub(exp, num(1)))), dBase)
+	}
+	if !dependsOn(base, v) {
+		// (c^u)' = c^u·ln(c)·u'
+		return mul(mul(pow(base, exp), call("ln", base)), dExp)
+	}
+	// (u^w)' = u^w·(w'·ln(u) + w·u'/u)
+	return mul(pow(base, exp), add(mul(dExp, call("ln", base)), div(mul(exp, dBase), base)))
+}
@@ -0,0 +1,130 @@
+package expr
+
+import (
+	"fmt"
+
+	"github.com/KaiserRed/numeric_methods/internal/autodiff"
+)
+
+var dualFunctions = map[string]func(autodiff.Dual) autodiff.Dual{
+	"sin":   autodiff.Sin,
+	"cos":   autodiff.Cos,
+	"tan":   autodiff.Tan,
+	"cot":   func(a autodiff.Dual) autodiff.Dual { return autodiff.Div(autodiff.Const(1), autodiff.Tan(a)) },
+	"asin":  autodiff.Asin,
+	"acos":  autodiff.Acos,
+	"atan":  autodiff.Atan,
+	"sinh":  autodiff.Sinh,
+	"cosh":  autodiff.Cosh,
+	"tanh":  autodiff.Tanh,
+	"exp":   autodiff.Exp,
+	"ln":    autodiff.Log,
+	"log":   autodiff.Log,
+	"log10": autodiff.Log10,
+	"log2":  autodiff.Log2,
+	"sqrt":  autodiff.Sqrt,
+	"cbrt":  autodiff.Cbrt,
+	"abs":   autodiff.Abs,
+}
+
+// CompileDual компилирует выражение в функцию над дуальными числами,
+// что даёт производные автоматическим дифференцированием.
+func CompileDual(n Node, vars []string, params map[string]float64) (func([]autodiff.Dual) autodiff.Dual, error) {
+	index := make(map[string]int, len(vars))
+	for i, v := range vars {
+		index[v] = i
+	}
+	return compileDual(n, index, params)
+}
+
+func compileDual(n Node, index map[string]int, params map[string]float64) (func([]autodiff.Dual) autodiff.Dual, error) {
+	switch n := n.(type) {
+	case Num:
+		c := autodiff.Const(n.Value)
+		return func([]autodiff.Dual) autodiff.Dual { return c }, nil
+
+	case Var:
+		if i, ok := index[n.Name]; ok {
+			return func(args []autodiff.Dual) autodiff.Dual { return args[i] }, nil
+		}
+		v, ok := params[n.Name]
+		if !ok {
+			v, ok = constants[n.Name]
+		}
+		if !ok {
+			return nil, fmt.Errorf("неизвестная переменная %q", n.Name)
+		}
+		c := autodiff.Const(v)
+		return func([]autodiff.Dual) autodiff.Dual { return c }, nil
+
+	case Unary:
+		x, err := compileDual(n.X, index, params)
+		if err != nil {
+			return nil, err
+		}
+		return func(args []autodiff.Dual) autodiff.Dual { return autodiff.Neg(x(args)) }, nil
+
+	case Binary:
+		l, err := compileDual(n.L, index, params)
+		if err != nil {
+			return nil, err
+		}
+		r, err := compileDual(n.R, index, params)
+		if err != nil {
+			return nil, err
+		}
+		var op func(a, b autodiff.Dual) autodiff.Dual
+		switch n.Op {
+		case '+':
+			op = autodiff.Add
+		case '-':
+			op = autodiff.Sub
+		case '*':
+			op = autodiff.Mul
+		case '/':
+			op = autodiff.Div
+		case '^':
+			op = autodiff.Pow
+		default:
+			return nil, fmt.Errorf("неизвестная операция %q", n.Op)
+		}
+		return func(args []autodiff.Dual) autodiff.Dual { return op(l(args), r(args)) }, nil
+
+	case Call:
+		args := make([]func([]autodiff.Dual) autodiff.Dual, len(n.Args))
+		for i, a := range n.Args {
+			c, err := compileDual(a, index, params)
+			if err != nil {
+				return nil, err
+			}
+			args[i] = c
+		}
+		switch n.Func {
+		case "pow":
+			return func(x []autodiff.Dual) autodiff.Dual { return autodiff.Pow(args[0](x), args[1](x)) }, nil
+		case "min":
+			return func(x []autodiff.Dual) autodiff.Dual {
+				a, b := args[0](x), args[1](x)
+				if b.Val < a.Val {
+					return b
+				}
+				return a
+			}, nil
+		case "max":
+			return func(x []autodiff.Dual) autodiff.Dual {
+				a, b := args[0](x), args[1](x)
+				if b.Val > a.Val {
+					return b
+				}
+				return a
+			}, nil
+		}
+		fn, ok := dualFunctions[n.Func]
+		if !ok || len(args) != 1 {
+			return nil, fmt.Errorf("неизвестная функция %q", n.Func)
+		}
+		arg := args[0]
+		return func(x []autodiff.Dual) autodiff.Dual { return fn(arg(x)) }, nil
+	}
+	return nil, fmt.Errorf("неизвестный тип узла %T", n)
+}
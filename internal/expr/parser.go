@@ -0,0 +1,245 @@
+package expr
+
+import (
+	"fmt"
+	"strconv"
+	"unicode"
+)
+
+type tokenKind int
+
+const (
+	tokEOF tokenKind = iota
+	tokNum
+	tokIdent
+	tokOp
+)
+
+type token struct {
+	kind tokenKind
+	text string
+	num  float64
+	pos  int
+}
+
+func tokenize(src string) ([]token, error) {
+	var tokens []token
+	runes := []rune(src)
+	for i := 0; i < len(runes); {
+		r := runes[i]
+		switch {
+		case unicode.IsSpace(r):
+			i++
+		case unicode.IsDigit(r) || r == '.':
+			start := i
+			for i < len(runes) && (unicode.IsDigit(runes[i]) || runes[i] == '.') {
+				i++
+			}
+			if i < len(runes) && (runes[i] == 'e' || runes[i] == 'E') {
+				j := i + 1
+				if j < len(runes) && (runes[j] == '+' || runes[j] == '-') {
+					j++
+				}
+				if j < len(runes) && unicode.IsDigit(runes[j]) {
+					i = j
+					for i < len(runes) && unicode.IsDigit(runes[i]) {
+						i++
+					}
+				}
+			}
+			text := string(runes[start:i])
+			val, err := strconv.ParseFloat(text, 64)
+			if err != nil {
+				return nil, fmt.Errorf("позиция %d: некорректное число %q", start+1, text)
+			}
+			tokens = append(tokens, token{kind: tokNum, text: text, num: val, pos: start})
+		case unicode.IsLetter(r) || r == '_':
+			start := i
+			for i < len(runes) && (unicode.IsLetter(runes[i]) || unicode.IsDigit(runes[i]) || runes[i] == '_') {
+				i++
+			}
+			tokens = append(tokens, token{kind: tokIdent, text: string(runes[start:i]), pos: start})
+		case r == '*' && i+1 < len(runes) && runes[i+1] == '*':
+			tokens = append(tokens, token{kind: tokOp, text: "^", pos: i})
+			i += 2
+		case r == '+' || r == '-' || r == '*' || r == '/' || r == '^' || r == '(' || r == ')' || r == ',':
+			tokens = append(tokens, token{kind: tokOp, text: string(r), pos: i})
+			i++
+		default:
+			return nil, fmt.Errorf("позиция %d: недопустимый символ %q", i+1, r)
+		}
+	}
+	tokens = append(tokens, token{kind: tokEOF, pos: len(runes)})
+	return tokens, nil
+}
+
+type parser struct {
+	tokens []token
+	pos    int
+}
+
+// Parse разбирает выражение вида "ln(x+2) - x^4 + 0.5".
+// Поддерживаются операции + - * / ^ (или **), унарный минус, скобки,
+// числа, переменные, константы pi и e и стандартные функции.
+func Parse(src string) (Node, error) {
+	tokens, err := tokenize(src)
+	if err != nil {
+		return nil, err
+	}
+	p := &parser{tokens: tokens}
+	n, err := p.parseExpr()
+	if err != nil {
+		return nil, err
+	}
+	if t := p.peek(); t.kind != tokEOF {
+		return nil, fmt.Errorf("позиция %d: лишний символ %q", t.pos+1, t.text)
+	}
+	return n, nil
+}
+
+func (p *parser) peek() token {
+	return p.tokens[p.pos]
+}
+
+func (p *parser) next() token {
+	t := p.tokens[p.pos]
+	if t.kind != tokEOF {
+		p.pos++
+	}
+	return t
+}
+
+func (p *parser) isOp(text string) bool {
+	t := p.peek()
+	return t.kind == tokOp && t.text == text
+}
+
+func (p *parser) expect(text string) error {
+	t := p.next()
+	if t.kind != tokOp || t.text != text {
+		return fmt.Errorf("позиция %d: ожидалось %q", t.pos+1, text)
+	}
+	return nil
+}
+
+// expr := term (('+' | '-') term)*
+func (p *parser) parseExpr() (Node, error) {
+	left, err := p.parseTerm()
+	if err != nil {
+		return nil, err
+	}
+	for p.isOp("+") || p.isOp("-") {
+		op := p.next().text[0]
+		right, err := p.parseTerm()
+		if err != nil {
+			return nil, err
+		}
+		left = Binary{Op: op, L: left, R: right}
+	}
+	return left, nil
+}
+
+// term := unary (('*' | '/') unary)*
+func (p *parser) parseTerm() (Node, error) {
+	left, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+	for p.isOp("*") || p.isOp("/") {
+		op := p.next().text[0]
+		right, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		left = Binary{Op: op, L: left, R: right}
+	}
+	return left, nil
+}
+
+// unary := ('-' | '+') unary | power
+func (p *parser) parseUnary() (Node, error) {
+	if p.isOp("-") {
+		p.next()
+		x, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		return Unary{X: x}, nil
+	}
+	if p.isOp("+") {
+		p.next()
+		return p.parseUnary()
+	}
+	return p.parsePower()
+}
+
+// power := primary ('^' unary)?
+func (p *parser) parsePower() (Node, error) {
+	base, err := p.parsePrimary()
+	if err != nil {
+		return nil, err
+	}
+	if p.isOp("^") {
+		p.next()
+		exp, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		return Binary{Op: '^', L: base, R: exp}, nil
+	}
+	return base, nil
+}
+
+// primary := number | ident | ident '(' args ')' | '(' expr ')'
+func (p *parser) parsePrimary() (Node, error) {
+	t := p.next()
+	switch t.kind {
+	case tokNum:
+		return Num{Value: t.num}, nil
+	case tokIdent:
+		if !p.isOp("(") {
+			return Var{Name: t.text}, nil
+		}
+		p.next()
+		var args []Node
+		if !p.isOp(")") {
+			for {
+				arg, err := p.parseExpr()
+				if err != nil {
+					return nil, err
+				}
+				args = append(args, arg)
+				if !p.isOp(",") {
+					break
+				}
+				p.next()
+			}
+		}
+		if err := p.expect(")"); err != nil {
+			return nil, err
+		}
+		fn, ok := functions[t.text]
+		if !ok {
+			return nil, fmt.Errorf("позиция %d: неизвестная функция %q", t.pos+1, t.text)
+		}
+		if len(args) != fn.arity {
+			return nil, fmt.Errorf("позиция %d: функция %s принимает %d аргумент(а), передано %d",
+				t.pos+1, t.text, fn.arity, len(args))
+		}
+		return Call{Func: t.text, Args: args}, nil
+	case tokOp:
+		if t.text == "(" {
+			n, err := p.parseExpr()
+			if err != nil {
+				return nil, err
+			}
+			if err := p.expect(")"); err != nil {
+				return nil, err
+			}
+			return n, nil
+		}
+		return nil, fmt.Errorf("позиция %d: неожиданный символ %q", t.pos+1, t.text)
+	default:
+		return nil, fmt.Errorf("неожиданный конец выражения")
+	}
+}
@@ -0,0 +1,190 @@
+package expr
+
+import (
+	"math"
+)
+
+// Simplify выполняет свёртку констант и алгебраические упрощения
+// (x+0, x·1, x·0, x^1, --x и т.п.) до неподвижной точки.
+func Simplify(n Node) Node {
+	for i := 0; i < 20; i++ {
+		next := simplify(n)
+		if next.String() == n.String() {
+			return next
+		}
+		n = next
+	}
+	return n
+}
+
+func isNum(n Node, v float64) bool {
+	c, ok := n.(Num)
+	return ok && c.Value == v
+}
+
+func simplify(n Node) Node {
+	switch n := n.(type) {
+	case Unary:
+		x := simplify(n.X)
+		switch x := x.(type) {
+		case Num:
+			return num(-x.Value)
+		case Unary:
+			return x.X
+		case Binary:
+			// -(c·x) → (-c)·x, -(c/x) → (-c)/x
+			if c, ok := x.L.(Num); ok && (x.Op == '*' || x.Op == '/') {
+				return Binary{Op: x.Op, L: num(-c.Value), R: x.R}
+			}
+		}
+		return neg(x)
+
+	case Binary:
+		l, r := simplify(n.L), simplify(n.R)
+		lc, lNum := l.(Num)
+		rc, rNum := r.(Num)
+		if lNum && rNum {
+			if v, ok := evalBinary(n.Op, lc.Value, rc.Value); ok {
+				return num(v)
+			}
+		}
+		switch n.Op {
+		case '+':
+			if isNum(l, 0) {
+				return r
+			}
+			if isNum(r, 0) {
+				return l
+			}
+			if u, ok := r.(Unary); ok {
+				return sub(l, u.X)
+			}
+			if rNum && rc.Value < 0 {
+				return sub(l, num(-rc.Value))
+			}
+			if u, ok := l.(Unary); ok {
+				return sub(r, u.X)
+			}
+		case '-':
+			if isNum(r, 0) {
+				return l
+			}
+			if isNum(l, 0) {
+				return neg(r)
+			}
+			if u, ok := r.(Unary); ok {
+				return add(l, u.X)
+			}
+			if rNum && rc.Value < 0 {
+				return add(l, num(-rc.Value))
+			}
+			if l.String() == r.String() {
+				return num(0)
+			}
+		case '*':
+			if isNum(l, 0) || isNum(r, 0) {
+				return num(0)
+			}
+			if isNum(l, 1) {
+				return r
+			}
+			if isNum(r, 1) {
+				return l
+			}
+			if isNum(l, -1) {
+				return neg(r)
+			}
+			if isNum(r, -1) {
+				return neg(l)
+			}
+			// Числовой множитель выносится влево: x·c → c·x
+			if rNum && !lNum {
+				return mul(r, l)
+			}
+			// c1·(c2·x) → (c1·c2)·x
+			if inner, ok := r.(Binary); ok && lNum && inner.Op == '*' {
+				if ic, ok := inner.L.(Num); ok {
+					return mul(num(lc.Value*ic.Value), inner.R)
+				}
+			}
+			if inner, ok := r.(Binary); ok && !lNum {
+				// x·(c·y) → c·(x·y)
+				if ic, ok := inner.L.(Num); ok && inner.Op == '*' {
+					return mul(ic, mul(l, inner.R))
+				}
+				// x·(1/y) → x/y
+				if inner.Op == '/' && isNum(inner.L, 1) {
+					return div(l, inner.R)
+				}
+			}
+			if u, ok := l.(Unary); ok {
+				return neg(mul(u.X, r))
+			}
+			if u, ok := r.(Unary); ok {
+				return neg(mul(l, u.X))
+			}
+		case '/':
+			if isNum(l, 0) {
+				return num(0)
+			}
+			if isNum(r, 1) {
+				return l
+			}
+			if l.String() == r.String() {
+				return num(1)
+			}
+			if u, ok := l.(Unary); ok {
+				return neg(div(u.X, r))
+			}
+		case '^':
+			if isNum(r, 1) {
+				return l
+			}
+			if isNum(r, 0) {
+				return num(1)
+			}
+		}
+		return Binary{Op: n.Op, L: l, R: r}
+
+	case Call:
+		args := make([]Node, len(n.Args))
+		allNum := true
+		for i, a := range n.Args {
+			args[i] = simplify(a)
+			if _, ok := args[i].(Num); !ok {
+				allNum = false
+			}
+		}
+		if allNum {
+			vals := make([]float64, len(args))
+			for i, a := range args {
+				vals[i] = a.(Num).Value
+			}
+			if v := functions[n.Func].eval(vals); !math.IsNaN(v) && !math.IsInf(v, 0) && v == math.Round(v*1e12)/1e12 {
+				return num(v)
+			}
+		}
+		return Call{Func: n.Func, Args: args}
+	}
+	return n
+}
+
+func evalBinary(op byte, a, b float64) (float64, bool) {
+	var v float64
+	switch op {
+	case '+':
+		v = a + b
+	case '-':
+		v = a - b
+	case '*':
+		v = a * b
+	case '/':
+		v = a / b
+	case '^':
+		v = math.Pow(a, b)
+	}
+	if math.IsNaN(v) || math.IsInf(v, 0) {
+		return 0, false
+	}
+	return v, true
+}
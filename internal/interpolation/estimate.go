@@ -0,0 +1,125 @@
+package interpolation
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/KaiserRed/numeric_methods/internal/svd_decompose"
+)
+
+// Максимальное число подотрезков при оценке производной и запас,
+// с которым оценка должна превосходить шум округления
+const (
+	maxDerivativeWindows = 64
+	noiseMargin          = 10
+)
+
+// Omega вычисляет ω(x) = ∏(x − x_i) по узлам интерполяции.
+func Omega(nodes []float64, x float64) float64 {
+	prod := 1.0
+	for _, xi := range nodes {
+		prod *= x - xi
+	}
+	return prod
+}
+
+// MaxAbsOmega находит max|ω(x)| на равномерной сетке из samples точек
+// отрезка [a, b] и точку, где он достигается.
+func MaxAbsOmega(nodes []float64, a, b float64, samples int) (float64, float64) {
+	best, at := 0.0, a
+	for i := 0; i < samples; i++ {
+		x := a + (b-a)*float64(i)/float64(max(samples-1, 1))
+		if w := math.Abs(Omega(nodes, x)); w > best {
+			best, at = w, x
+		}
+	}
+	return best, at
+}
+
+// APrioriBound — оценка погрешности интерполяции по n+1 узлу:
+// |f(x) − P(x)| <= M·|ω(x)|/(n+1)!, где M >= max|f⁽ⁿ⁺¹⁾| на отрезке.
+func APrioriBound(nodes []float64, x, M float64) float64 {
+	return M * math.Abs(Omega(nodes, x)) / factorial(len(nodes))
+}
+
+// EstimateDerivativeBound оценивает max|f⁽ᵏ⁾| на [a, b] через
+// f⁽ᵏ⁾(ξ) = k!·f[t₀, …, t_k]: отрезок делится на 1, 2, 4, … подотрезка,
+// на каждом берутся k+1 узлов Чебышёва. Чем мельче подотрезки, тем ближе
+// оценка к максимуму, но тем сильнее шум округления, который оценивается
+// как ε·Σ|f(t_j)/ω'(t_j)|·k!. Возвращается наибольшая из оценок, заметно
+// превосходящих шум (грубое разбиение может дать ноль из-за симметрии f).
+// Это оценка снизу для истинного максимума; если все оценки утонули в шуме,
+// возвращается ошибка.
+func EstimateDerivativeBound(f func(float64) float64, a, b float64, k int) (float64, error) {
+	if a >= b {
+		return 0, fmt.Errorf("левая граница должна быть меньше правой")
+	}
+	if k < 0 {
+		return 0, fmt.Errorf("порядок производной должен быть неотрицательным")
+	}
+
+	best, found := 0.0, false
+	for windows := 1; windows <= maxDerivativeWindows; windows *= 2 {
+		w := (b - a) / float64(windows)
+		estimate, noise := 0.0, 0.0
+		for s := 0; s < windows; s++ {
+			lo := a + float64(s)*w
+			ts, _ := ChebyshevFirstKind(lo, lo+w, k+1)
+			ys := make([]float64, len(ts))
+			for j, t := range ts {
+				ys[j] = f(t)
+			}
+			p, err := NewLagrange(ts, ys)
+			if err != nil {
+				return 0, err
+			}
+			// f[t₀, …, t_k] = Σ w_j·y_j, шум — ε·Σ|w_j·y_j|
+			dd, abs := 0.0, 0.0
+			for j, wj := range p.Weights() {
+				dd += wj * ys[j]
+				abs += math.Abs(wj * ys[j])
+			}
+			estimate = math.Max(estimate, math.Abs(dd))
+			noise = math.Max(noise, svd_decompose.MachineEpsilon*abs)
+		}
+		if math.IsNaN(estimate) || math.IsInf(estimate, 0) {
+			return 0, fmt.Errorf("функция не определена на отрезке")
+		}
+		if estimate > noiseMargin*noise {
+			best, found = math.Max(best, estimate*factorial(k)), true
+		}
+	}
+	if !found {
+		return 0, fmt.Errorf("оценка производной порядка %d искажена округлением, задайте M явно", k)
+	}
+	return best, nil
+}
+
+// ErrorEstimate — апостериорная оценка погрешности по следующему члену
+// формы Ньютона: f(x) − P(x) ≈ f[x₀, …, xₙ, x*]·ω(x), где x* — дополнительный
+// узел со значением y*. Многочлен при этом не изменяется.
+func (p *Newton) ErrorEstimate(x, xNext, yNext float64) (float64, error) {
+	n := len(p.xs)
+	if n == 0 {
+		return 0, fmt.Errorf("не заданы узлы интерполяции")
+	}
+	for _, xi := range p.xs {
+		if xi == xNext {
+			return 0, fmt.Errorf("узел %g повторяется", xNext)
+		}
+	}
+	// Новая диагональ таблицы: f[x_i, …, x_{n−1}, x*], i = n−1, …, 0
+	d := yNext
+	for i := n - 1; i >= 0; i-- {
+		d = (d - p.table[i][n-1-i]) / (xNext - p.xs[i])
+	}
+	return d * Omega(p.xs, x), nil
+}
+
+func factorial(n int) float64 {
+	f := 1.0
+	for i := 2; i <= n; i++ {
+		f *= float64(i)
+	}
+	return f
+}
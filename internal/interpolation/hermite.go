@@ -0,0 +1,165 @@
+package interpolation
+
+import (
+	"fmt"
+	"sort"
+)
+
+// HermiteNode — узел с известными значениями функции и производных:
+// Values[k] = f⁽ᵏ⁾(X), k = 0, …, m−1.
+type HermiteNode struct {
+	X      float64
+	Values []float64
+}
+
+// Hermite — интерполяционный многочлен Эрмита в форме Ньютона. Узел с m
+// известными производными входит в последовательность z m раз подряд, а
+// разделённые разности по совпадающим узлам заменяются производными:
+// f[z_i, …, z_{i+j}] = f⁽ʲ⁾(z_i)/j!, если z_i = z_{i+j}.
+type Hermite struct {
+	zs    []float64
+	table [][]float64
+}
+
+func NewHermite(nodes []HermiteNode) (*Hermite, error) {
+	if len(nodes) == 0 {
+		return nil, fmt.Errorf("не заданы узлы интерполяции")
+	}
+	seen := make(map[float64]bool, len(nodes))
+	var zs []float64
+	var derivs [][]float64
+	for _, node := range nodes {
+		if len(node.Values) == 0 {
+			return nil, fmt.Errorf("в узле %g не задано значение функции", node.X)
+		}
+		if seen[node.X] {
+			return nil, fmt.Errorf("узел %g повторяется", node.X)
+		}
+		seen[node.X] = true
+		for range node.Values {
+			zs = append(zs, node.X)
+			derivs = append(derivs, node.Values)
+		}
+	}
+
+	n := len(zs)
+	table := make([][]float64, n)
+	for i := range table {
+		table[i] = make([]float64, n-i)
+		table[i][0] = derivs[i][0]
+	}
+	for j := 1; j < n; j++ {
+		for i := 0; i < n-j; i++ {
+			if zs[i+j] == zs[i] {
+				table[i][j] = derivs[i][j] / factorial(j)
+			} else {
+				table[i][j] = (table[i+1][j-1] - table[i][j-1]) / (zs[i+j] - zs[i])
+			}
+		}
+	}
+	return &Hermite{zs: zs, table: table}, nil
+}
+
+// Degree — степень многочлена: суммарное число условий минус один.
+func (p *Hermite) Degree() int {
+	return len(p.zs) - 1
+}
+
+// Nodes возвращает последовательность узлов z с повторениями.
+func (p *Hermite) Nodes() []float64 {
+	return append([]float64(nil), p.zs...)
+}
+
+func (p *Hermite) Coefficients() []float64 {
+	return append([]float64(nil), p.table[0]...)
+}
+
+// Table возвращает копию таблицы разделённых разностей с кратными узлами.
+func (p *Hermite) Table() [][]float64 {
+	table := make([][]float64, len(p.table))
+	for i, row := range p.table {
+		table[i] = append([]float64(nil), row...)
+	}
+	return table
+}
+
+func (p *Hermite) Eval(x float64) float64 {
+	value, _ := newtonForm(p.zs, p.table[0], x)
+	return value
+}
+
+func (p *Hermite) EvalMany(xs []float64) []float64 {
+	return evalMany(p.Eval, xs)
+}
+
+func (p *Hermite) Derivative(x float64) float64 {
+	_, d := newtonForm(p.zs, p.table[0], x)
+	return d
+}
+
+// PiecewiseHermite — кусочно-кубический эрмитов интерполянт: на каждом
+// отрезке [x_i, x_{i+1}] кубический многочлен совпадает с f и f' на концах.
+// Первая производная непрерывна, вторая в общем случае — нет. Вне [x₀, xₙ]
+// продолжается крайний кубический многочлен.
+type PiecewiseHermite struct {
+	xs, ys, ds []float64
+}
+
+// NewPiecewiseHermite строит интерполянт по возрастающим узлам xs,
+// значениям ys и производным ds.
+func NewPiecewiseHermite(xs, ys, ds []float64) (*PiecewiseHermite, error) {
+	if len(xs) < 2 {
+		return nil, fmt.Errorf("нужно хотя бы два узла")
+	}
+	if len(ys) != len(xs) || len(ds) != len(xs) {
+		return nil, fmt.Errorf("число значений и производных должно совпадать с числом узлов (%d)", len(xs))
+	}
+	for i := 1; i < len(xs); i++ {
+		if xs[i] <= xs[i-1] {
+			return nil, fmt.Errorf("узлы должны строго возрастать")
+		}
+	}
+	return &PiecewiseHermite{
+		xs: append([]float64(nil), xs...),
+		ys: append([]float64(nil), ys...),
+		ds: append([]float64(nil), ds...),
+	}, nil
+}
+
+// interval возвращает номер отрезка, содержащего x (двоичный поиск).
+func (p *PiecewiseHermite) interval(x float64) int {
+	i := sort.SearchFloat64s(p.xs, x) - 1
+	return min(max(i, 0), len(p.xs)-2)
+}
+
+// eval вычисляет значение и производную через базисные многочлены Эрмита
+// от t = (x − x_i)/h.
+func (p *PiecewiseHermite) eval(x float64) (float64, float64) {
+	i := p.interval(x)
+	h := p.xs[i+1] - p.xs[i]
+	t := (x - p.xs[i]) / h
+	t2, t3 := t*t, t*t*t
+
+	h00, h10 := 2*t3-3*t2+1, t3-2*t2+t
+	h01, h11 := -2*t3+3*t2, t3-t2
+	value := h00*p.ys[i] + h10*h*p.ds[i] + h01*p.ys[i+1] + h11*h*p.ds[i+1]
+
+	d00, d10 := 6*t2-6*t, 3*t2-4*t+1
+	d01, d11 := -6*t2+6*t, 3*t2-2*t
+	d := (d00*p.ys[i]+d01*p.ys[i+1])/h + d10*p.ds[i] + d11*p.ds[i+1]
+	return value, d
+}
+
+func (p *PiecewiseHermite) Eval(x float64) float64 {
+	value, _ := p.eval(x)
+	return value
+}
+
+func (p *PiecewiseHermite) EvalMany(xs []float64) []float64 {
+	return evalMany(p.Eval, xs)
+}
+
+func (p *PiecewiseHermite) Derivative(x float64) float64 {
+	_, d := p.eval(x)
+	return d
+}
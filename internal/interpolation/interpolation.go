@@ -0,0 +1,45 @@
+package interpolation
+
+import "fmt"
+
+// Interpolator — функция, восстановленная по значениям в узлах.
+// EvalMany вычисляет значения в нескольких точках сразу.
+type Interpolator interface {
+	Eval(x float64) float64
+	EvalMany(xs []float64) []float64
+	Derivative(x float64) float64
+}
+
+// checkNodes проверяет, что узлов хотя бы один, число значений совпадает
+// с числом узлов и узлы попарно различны.
+func checkNodes(xs, ys []float64) error {
+	if len(xs) == 0 {
+		return fmt.Errorf("не заданы узлы интерполяции")
+	}
+	if len(xs) != len(ys) {
+		return fmt.Errorf("число узлов (%d) не совпадает с числом значений (%d)", len(xs), len(ys))
+	}
+	seen := make(map[float64]bool, len(xs))
+	for _, x := range xs {
+		if seen[x] {
+			return fmt.Errorf("узел %g повторяется", x)
+		}
+		seen[x] = true
+	}
+	return nil
+}
+
+func evalMany(eval func(float64) float64, xs []float64) []float64 {
+	ys := make([]float64, len(xs))
+	for i, x := range xs {
+		ys[i] = eval(x)
+	}
+	return ys
+}
+
+var (
+	_ Interpolator = (*Lagrange)(nil)
+	_ Interpolator = (*Newton)(nil)
+	_ Interpolator = (*Hermite)(nil)
+	_ Interpolator = (*PiecewiseHermite)(nil)
+)
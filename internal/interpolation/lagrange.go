@@ -0,0 +1,79 @@
+package interpolation
+
+// Lagrange — интерполяционный многочлен Лагранжа в барицентрической
+// форме. Веса w_j = 1/∏_{k≠j}(x_j − x_k) вычисляются один раз за O(n²),
+// после чего значение в точке стоит O(n):
+// L(x) = Σ w_j·y_j/(x − x_j) / Σ w_j/(x − x_j).
+type Lagrange struct {
+	xs, ys  []float64
+	weights []float64
+}
+
+func NewLagrange(xs, ys []float64) (*Lagrange, error) {
+	if err := checkNodes(xs, ys); err != nil {
+		return nil, err
+	}
+	n := len(xs)
+	weights := make([]float64, n)
+	for j := 0; j < n; j++ {
+		prod := 1.0
+		for k := 0; k < n; k++ {
+			if k != j {
+				prod *= xs[j] - xs[k]
+			}
+		}
+		weights[j] = 1 / prod
+	}
+	return &Lagrange{
+		xs:      append([]float64(nil), xs...),
+		ys:      append([]float64(nil), ys...),
+		weights: weights,
+	}, nil
+}
+
+// Weights возвращает барицентрические веса w_j = 1/ω'(x_j).
+func (p *Lagrange) Weights() []float64 {
+	return append([]float64(nil), p.weights...)
+}
+
+func (p *Lagrange) Eval(x float64) float64 {
+	var num, den float64
+	for j, xj := range p.xs {
+		if x == xj {
+			return p.ys[j]
+		}
+		t := p.weights[j] / (x - xj)
+		num += t * p.ys[j]
+		den += t
+	}
+	return num / den
+}
+
+func (p *Lagrange) EvalMany(xs []float64) []float64 {
+	return evalMany(p.Eval, xs)
+}
+
+// Derivative: вне узлов L'(x) = Σ s_j·(L(x) − y_j)/(x − x_j) / Σ s_j,
+// s_j = w_j/(x − x_j); в узле x_i L'(x_i) = Σ_{j≠i} (w_j/w_i)·(y_j − y_i)/(x_i − x_j).
+func (p *Lagrange) Derivative(x float64) float64 {
+	for i, xi := range p.xs {
+		if x == xi {
+			var d float64
+			for j, xj := range p.xs {
+				if j != i {
+					d += p.weights[j] / p.weights[i] * (p.ys[j] - p.ys[i]) / (xi - xj)
+				}
+			}
+			return d
+		}
+	}
+
+	value := p.Eval(x)
+	var num, den float64
+	for j, xj := range p.xs {
+		t := p.weights[j] / (x - xj)
+		num += t * (value - p.ys[j]) / (x - xj)
+		den += t
+	}
+	return num / den
+}
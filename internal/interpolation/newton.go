@@ -0,0 +1,106 @@
+package interpolation
+
+import "fmt"
+
+// Newton — интерполяционный многочлен Ньютона
+// P(x) = f[x₀] + f[x₀,x₁](x − x₀) + … + f[x₀,…,xₙ](x − x₀)…(x − xₙ₋₁).
+// Таблица разделённых разностей хранится треугольной: table[i][j] =
+// f[x_i, …, x_{i+j}], так что новый узел добавляется за O(n) без пересчёта
+// уже вычисленных разностей.
+type Newton struct {
+	xs    []float64
+	table [][]float64
+}
+
+func NewNewton(xs, ys []float64) (*Newton, error) {
+	if err := checkNodes(xs, ys); err != nil {
+		return nil, err
+	}
+	p := &Newton{}
+	for i := range xs {
+		if err := p.Add(xs[i], ys[i]); err != nil {
+			return nil, err
+		}
+	}
+	return p, nil
+}
+
+// Add добавляет узел (x, y): вычисляется только новая диагональ таблицы
+// f[x_i, …, x_n], i = n, …, 0, и степень многочлена растёт на единицу.
+func (p *Newton) Add(x, y float64) error {
+	for _, xi := range p.xs {
+		if xi == x {
+			return fmt.Errorf("узел %g повторяется", x)
+		}
+	}
+	n := len(p.xs)
+	p.xs = append(p.xs, x)
+	p.table = append(p.table, []float64{y})
+	for i := n - 1; i >= 0; i-- {
+		j := n - i
+		d := (p.table[i+1][j-1] - p.table[i][j-1]) / (x - p.xs[i])
+		p.table[i] = append(p.table[i], d)
+	}
+	return nil
+}
+
+// Degree — степень многочлена (число узлов минус один).
+func (p *Newton) Degree() int {
+	return len(p.xs) - 1
+}
+
+func (p *Newton) Nodes() []float64 {
+	return append([]float64(nil), p.xs...)
+}
+
+// Coefficients возвращает коэффициенты f[x₀], f[x₀,x₁], …, f[x₀,…,xₙ].
+func (p *Newton) Coefficients() []float64 {
+	if len(p.table) == 0 {
+		return nil
+	}
+	return append([]float64(nil), p.table[0]...)
+}
+
+// Table возвращает копию таблицы разделённых разностей: строка i содержит
+// f[x_i], f[x_i,x_{i+1}], …, f[x_i,…,x_n].
+func (p *Newton) Table() [][]float64 {
+	table := make([][]float64, len(p.table))
+	for i, row := range p.table {
+		table[i] = append([]float64(nil), row...)
+	}
+	return table
+}
+
+// Eval вычисляет P(x) по схеме Горнера для формы Ньютона.
+func (p *Newton) Eval(x float64) float64 {
+	value, _ := p.eval(x)
+	return value
+}
+
+func (p *Newton) EvalMany(xs []float64) []float64 {
+	return evalMany(p.Eval, xs)
+}
+
+func (p *Newton) Derivative(x float64) float64 {
+	_, d := p.eval(x)
+	return d
+}
+
+func (p *Newton) eval(x float64) (float64, float64) {
+	if len(p.xs) == 0 {
+		return 0, 0
+	}
+	return newtonForm(p.xs, p.table[0], x)
+}
+
+// newtonForm одновременно вычисляет P(x) и P'(x) для многочлена
+// Σ c_k·(x − z₀)…(x − z_{k−1}); узлы z могут повторяться.
+func newtonForm(zs, c []float64, x float64) (float64, float64) {
+	n := len(c) - 1
+	value, d := c[n], 0.0
+	for k := n - 1; k >= 0; k-- {
+		d = d*(x-zs[k]) + value
+		value = value*(x-zs[k]) + c[k]
+	}
+	return value, d
+}
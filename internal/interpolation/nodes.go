@@ -0,0 +1,62 @@
+package interpolation
+
+import (
+	"fmt"
+	"math"
+)
+
+func checkInterval(a, b float64, n, minNodes int) error {
+	if a >= b {
+		return fmt.Errorf("левая граница должна быть меньше правой")
+	}
+	if n < minNodes {
+		return fmt.Errorf("число узлов должно быть не меньше %d", minNodes)
+	}
+	return nil
+}
+
+// Equispaced возвращает n равноотстоящих узлов a = x₀ < … < xₙ₋₁ = b.
+func Equispaced(a, b float64, n int) ([]float64, error) {
+	if err := checkInterval(a, b, n, 2); err != nil {
+		return nil, err
+	}
+	xs := make([]float64, n)
+	h := (b - a) / float64(n-1)
+	for i := range xs {
+		xs[i] = a + float64(i)*h
+	}
+	xs[n-1] = b
+	return xs, nil
+}
+
+// ChebyshevFirstKind возвращает n корней многочлена Чебышёва Tₙ,
+// перенесённых на [a, b]: x_k = (a+b)/2 + (b−a)/2·cos((2k+1)π/(2n)).
+// Для них max|ω(x)| = 2·((b−a)/4)ⁿ — наименьшее возможное значение.
+// Узлы возвращаются по возрастанию.
+func ChebyshevFirstKind(a, b float64, n int) ([]float64, error) {
+	if err := checkInterval(a, b, n, 1); err != nil {
+		return nil, err
+	}
+	xs := make([]float64, n)
+	for k := range xs {
+		t := math.Cos(float64(2*k+1) * math.Pi / float64(2*n))
+		xs[n-1-k] = (a+b)/2 + (b-a)/2*t
+	}
+	return xs, nil
+}
+
+// ChebyshevSecondKind возвращает n точек экстремумов Tₙ₋₁ (узлы
+// Чебышёва–Лобатто) на [a, b], включая концы отрезка:
+// x_k = (a+b)/2 + (b−a)/2·cos(kπ/(n−1)). Узлы возвращаются по возрастанию.
+func ChebyshevSecondKind(a, b float64, n int) ([]float64, error) {
+	if err := checkInterval(a, b, n, 2); err != nil {
+		return nil, err
+	}
+	xs := make([]float64, n)
+	for k := range xs {
+		t := math.Cos(float64(k) * math.Pi / float64(n-1))
+		xs[n-1-k] = (a+b)/2 + (b-a)/2*t
+	}
+	xs[0], xs[n-1] = a, b
+	return xs, nil
+}
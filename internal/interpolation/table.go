@@ -0,0 +1,43 @@
+package interpolation
+
+import (
+	"fmt"
+	"io"
+	"strings"
+)
+
+// WriteTable печатает треугольную таблицу разделённых разностей:
+// строка i — узел z_i и разности f[z_i, …, z_{i+j}]. Разности по
+// совпадающим узлам (z_i = z_{i+j}), взятые из производных, отмечаются «*».
+func WriteTable(w io.Writer, zs []float64, table [][]float64) error {
+	if len(zs) != len(table) {
+		return fmt.Errorf("число узлов (%d) не совпадает с числом строк таблицы (%d)", len(zs), len(table))
+	}
+
+	var buf strings.Builder
+	fmt.Fprintf(&buf, "%3s %10s", "i", "z_i")
+	for j := range table {
+		fmt.Fprintf(&buf, " %15s", fmt.Sprintf("порядок %d", j))
+	}
+	buf.WriteByte('\n')
+
+	confluent := false
+	for i, row := range table {
+		line := fmt.Sprintf("%3d %10.4f", i, zs[i])
+		for j, v := range row {
+			mark := " "
+			if j > 0 && zs[i+j] == zs[i] {
+				mark, confluent = "*", true
+			}
+			line += fmt.Sprintf(" %14.6f%s", v, mark)
+		}
+		buf.WriteString(strings.TrimRight(line, " "))
+		buf.WriteByte('\n')
+	}
+	if confluent {
+		buf.WriteString("* — совпадающие узлы: f[z_i, …, z_{i+j}] = f⁽ʲ⁾(z_i)/j!\n")
+	}
+
+	_, err := io.WriteString(w, buf.String())
+	return err
+}
@@ -0,0 +1,96 @@
+package matrix_func
+
+import (
+	"fmt"
+	"math"
+)
+
+func identity(n int) [][]float64 {
+	I := make([][]float64, n)
+	for i := range I {
+		I[i] = make([]float64, n)
+		I[i][i] = 1
+	}
+	return I
+}
+
+func copyMatrix(A [][]float64) [][]float64 {
+	C := make([][]float64, len(A))
+	for i := range A {
+		C[i] = make([]float64, len(A[i]))
+		copy(C[i], A[i])
+	}
+	return C
+}
+
+func multiply(A, B [][]float64) [][]float64 {
+	n := len(A)
+	C := make([][]float64, n)
+	for i := range C {
+		C[i] = make([]float64, len(B[0]))
+		for k := range B {
+			if A[i][k] == 0 {
+				continue
+			}
+			for j := range C[i] {
+				C[i][j] += A[i][k] * B[k][j]
+			}
+		}
+	}
+	return C
+}
+
+// linear возвращает Σ coeffs[k]·terms[k]
+func linear(coeffs []float64, terms ...[][]float64) [][]float64 {
+	n := len(terms[0])
+	C := make([][]float64, n)
+	for i := range C {
+		C[i] = make([]float64, n)
+		for k, T := range terms {
+			for j := 0; j < n; j++ {
+				C[i][j] += coeffs[k] * T[i][j]
+			}
+		}
+	}
+	return C
+}
+
+func scale(A [][]float64, s float64) [][]float64 {
+	return linear([]float64{s}, A)
+}
+
+// Максимальная сумма модулей по столбцам
+func norm1(A [][]float64) float64 {
+	max := 0.0
+	for j := range A {
+		sum := 0.0
+		for i := range A {
+			sum += math.Abs(A[i][j])
+		}
+		max = math.Max(max, sum)
+	}
+	return max
+}
+
+func frobenius(A [][]float64) float64 {
+	sum := 0.0
+	for i := range A {
+		for _, v := range A[i] {
+			sum += v * v
+		}
+	}
+	return math.Sqrt(sum)
+}
+
+func checkSquare(A [][]float64) error {
+	n := len(A)
+	if n == 0 {
+		return fmt.Errorf("матрица пуста")
+	}
+	for _, row := range A {
+		if len(row) != n {
+			return fmt.Errorf("матрица должна быть квадратной")
+		}
+	}
+	return nil
+}
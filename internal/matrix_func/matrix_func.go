@@ -0,0 +1,215 @@
+package matrix_func
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/KaiserRed/numeric_methods/internal/eigen"
+	"github.com/KaiserRed/numeric_methods/internal/lu_decompose"
+)
+
+const maxIterations = 100
+
+// Коэффициенты аппроксимации Паде степени 13 (Higham, 2005)
+var pade13 = []float64{
+	64764752532480000, 32382376266240000, 7771770303897600, 1187353796428800,
+	129060195264000, 10559470521600, 670442572800, 33522128640,
+	1323241920, 40840800, 960960, 16380, 182, 1,
+}
+
+const theta13 = 5.371920351148152
+
+// Exp вычисляет exp(A) методом масштабирования и возведения в квадрат
+// с аппроксимацией Паде [13/13].
+func Exp(A [][]float64) ([][]float64, error) {
+	if err := checkSquare(A); err != nil {
+		return nil, err
+	}
+	n := len(A)
+
+	s := 0
+	if norm := norm1(A); norm > theta13 {
+		s = int(math.Ceil(math.Log2(norm / theta13)))
+	}
+	As := scale(A, math.Pow(2, -float64(s)))
+
+	I := identity(n)
+	A2 := multiply(As, As)
+	A4 := multiply(A2, A2)
+	A6 := multiply(A4, A2)
+	b := pade13
+
+	U := multiply(A6, linear([]float64{b[13], b[11], b[9]}, A6, A4, A2))
+	U = linear([]float64{1, b[7], b[5], b[3], b[1]}, U, A6, A4, A2, I)
+	U = multiply(As, U)
+	V := multiply(A6, linear([]float64{b[12], b[10], b[8]}, A6, A4, A2))
+	V = linear([]float64{1, b[6], b[4], b[2], b[0]}, V, A6, A4, A2, I)
+
+	// (V - U)·R = V + U
+	Q, err := lu_decompose.InverseMatrix(linear([]float64{1, -1}, V, U))
+	if err != nil {
+		return nil, fmt.Errorf("знаменатель Паде вырожден: %w", err)
+	}
+	R := multiply(Q, linear([]float64{1, 1}, V, U))
+
+	for i := 0; i < s; i++ {
+		R = multiply(R, R)
+	}
+	return R, nil
+}
+
+// Sqrt вычисляет главный квадратный корень итерациями Денмана–Биверса:
+// Y₀ = A, Z₀ = I, Y_{k+1} = (Y_k + Z_k⁻¹)/2, Z_{k+1} = (Z_k + Y_k⁻¹)/2.
+func Sqrt(A [][]float64, epsilon float64) ([][]float64, int, error) {
+	if err := checkSquare(A); err != nil {
+		return nil, 0, err
+	}
+	Y := copyMatrix(A)
+	Z := identity(len(A))
+
+	for k := 1; k <= maxIterations; k++ {
+		Yinv, err := lu_decompose.InverseMatrix(Y)
+		if err != nil {
+			return nil, k, fmt.Errorf("итерация Денмана–Биверса %d: %w", k, err)
+		}
+		Zinv, err := lu_decompose.InverseMatrix(Z)
+		if err != nil {
+			return nil, k, fmt.Errorf("итерация Денмана–Биверса %d: %w", k, err)
+		}
+		Ynext := linear([]float64{0.5, 0.5}, Y, Zinv)
+		Z = linear([]float64{0.5, 0.5}, Z, Yinv)
+
+		diff := frobenius(linear([]float64{1, -1}, Ynext, Y))
+		Y = Ynext
+		if diff <= epsilon*frobenius(Y) {
+			return Y, k, nil
+		}
+	}
+	return Y, maxIterations, fmt.Errorf("итерации Денмана–Биверса не сошлись за %d шагов", maxIterations)
+}
+
+// Узлы и веса квадратуры Гаусса–Лежандра на [-1, 1] (8 точек)
+var (
+	gaussNodes   = []float64{-0.9602898564975363, -0.7966664774136267, -0.5255324099163290, -0.1834346424956498, 0.1834346424956498, 0.5255324099163290, 0.7966664774136267, 0.9602898564975363}
+	gaussWeights = []float64{0.1012285362903763, 0.2223810344533745, 0.3137066458778873, 0.3626837833783620, 0.3626837833783620, 0.3137066458778873, 0.2223810344533745, 0.1012285362903763}
+)
+
+// Log вычисляет главный логарифм обратным масштабированием и возведением
+// в квадрат: A^{1/2^s} ≈ I, log(I + X) = ∫₀¹ X(I + tX)⁻¹ dt по Гауссу–Лежандру,
+// log A = 2^s·log(A^{1/2^s}).
+func Log(A [][]float64, epsilon float64) ([][]float64, error) {
+	if err := checkSquare(A); err != nil {
+		return nil, err
+	}
+	n := len(A)
+	I := identity(n)
+
+	T := copyMatrix(A)
+	s := 0
+	for norm1(linear([]float64{1, -1}, T, I)) > 0.25 {
+		if s >= 64 {
+			return nil, fmt.Errorf("не удалось приблизить A^{1/2^s} к единичной матрице")
+		}
+		root, _, err := Sqrt(T, epsilon)
+		if err != nil {
+			return nil, err
+		}
+		T = root
+		s++
+	}
+
+	X := linear([]float64{1, -1}, T, I)
+	L := make([][]float64, n)
+	for i := range L {
+		L[i] = make([]float64, n)
+	}
+	for k, node := range gaussNodes {
+		t := (node + 1) / 2
+		inv, err := lu_decompose.InverseMatrix(linear([]float64{1, t}, I, X))
+		if err != nil {
+			return nil, fmt.Errorf("матрица имеет собственные значения на отрицательной полуоси: %w", err)
+		}
+		L = linear([]float64{1, gaussWeights[k] / 2}, L, multiply(X, inv))
+	}
+
+	return scale(L, math.Pow(2, float64(s))), nil
+}
+
+// Power вычисляет A^p. Для целых p используется бинарное возведение
+// (при p < 0 — обращение), для дробных — exp(p·log A).
+func Power(A [][]float64, p float64, epsilon float64) ([][]float64, error) {
+	if err := checkSquare(A); err != nil {
+		return nil, err
+	}
+
+	if p == math.Trunc(p) && math.Abs(p) < 1<<31 {
+		base := A
+		if p < 0 {
+			inv, err := lu_decompose.InverseMatrix(A)
+			if err != nil {
+				return nil, err
+			}
+			base = inv
+		}
+		result := identity(len(A))
+		for k := int(math.Abs(p)); k > 0; k >>= 1 {
+			if k&1 == 1 {
+				result = multiply(result, base)
+			}
+			base = multiply(base, base)
+		}
+		return result, nil
+	}
+
+	L, err := Log(A, epsilon)
+	if err != nil {
+		return nil, err
+	}
+	return Exp(scale(L, p))
+}
+
+// Symmetric вычисляет f(A) = V·f(Λ)·Vᵀ для симметричной матрицы через
+// собственное разложение, найденное методом вращений Якоби.
+func Symmetric(A [][]float64, f func(float64) float64, epsilon float64) ([][]float64, error) {
+	if err := checkSquare(A); err != nil {
+		return nil, err
+	}
+	if !eigen.IsSymmetric(A) {
+		return nil, fmt.Errorf("матрица не симметрическая")
+	}
+
+	values, V, _, _ := eigen.JacobiRotation(A, epsilon)
+	n := len(A)
+	F := make([][]float64, n)
+	for i := range F {
+		F[i] = make([]float64, n)
+	}
+	for k, lambda := range values {
+		fl := f(lambda)
+		if math.IsNaN(fl) || math.IsInf(fl, 0) {
+			return nil, fmt.Errorf("функция не определена в собственном значении %.6g", lambda)
+		}
+		for i := 0; i < n; i++ {
+			vi := V[i][k] * fl
+			for j := 0; j < n; j++ {
+				F[i][j] += vi * V[j][k]
+			}
+		}
+	}
+	return F, nil
+}
+
+// EigenDiscrepancy возвращает относительное расхождение ‖F − f(A)‖/‖f(A)‖
+// (норма Фробениуса), где f(A) вычислена через собственное разложение.
+// Применимо только к симметричным матрицам.
+func EigenDiscrepancy(A, F [][]float64, f func(float64) float64, epsilon float64) (float64, error) {
+	reference, err := Symmetric(A, f, epsilon)
+	if err != nil {
+		return 0, err
+	}
+	norm := frobenius(reference)
+	if norm == 0 {
+		norm = 1
+	}
+	return frobenius(linear([]float64{1, -1}, F, reference)) / norm, nil
+}
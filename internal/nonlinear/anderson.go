@@ -0,0 +1,88 @@
+package nonlinear
+
+import (
+	"fmt"
+
+	"github.com/KaiserRed/numeric_methods/internal/svd_decompose"
+)
+
+// Anderson ускоряет метод простой итерации x_{k+1} = φ(x_k) по схеме
+// Андерсона: с невязками g_k = φ(x_k) − x_k последних depth шагов решается
+// задача наименьших квадратов min‖g_k − ΔG·γ‖, и новое приближение
+// x_{k+1} = φ(x_k) − (ΔX + ΔG)·γ. При depth = 0 получается обычная итерация.
+// Остановка — как у остальных методов, по Params.done с ‖g_k‖ в роли
+// и шага, и нормы невязки.
+func Anderson(phi func([]float64) []float64, x0 []float64, depth int, params Params) (Result, error) {
+	if err := params.validate(); err != nil {
+		return Result{X: clone(x0)}, err
+	}
+	if depth < 0 {
+		return Result{X: clone(x0)}, fmt.Errorf("глубина истории должна быть неотрицательной")
+	}
+
+	n := len(x0)
+	x := clone(x0)
+	res := Result{X: clone(x)}
+	var dX, dG [][]float64 // столбцы разностей x и g последних шагов
+	var xPrev, gPrev []float64
+
+	for i := 0; i < params.MaxIter; i++ {
+		fx := phi(x)
+		res.FEvals++
+		if !isFinite(fx) {
+			return res, fmt.Errorf("итерация %d: получено недопустимое значение", i+1)
+		}
+		g := make([]float64, n)
+		for k := range g {
+			g[k] = fx[k] - x[k]
+		}
+		// g — одновременно шаг простой итерации и невязка уравнения x = φ(x)
+		if gNorm := norm(g); params.done(x, gNorm, gNorm) {
+			res.Converged = true
+			return res, nil
+		}
+
+		if gPrev != nil && depth > 0 {
+			dx, dg := make([]float64, n), make([]float64, n)
+			for k := 0; k < n; k++ {
+				dx[k] = x[k] - xPrev[k]
+				dg[k] = g[k] - gPrev[k]
+			}
+			dX, dG = append(dX, dx), append(dG, dg)
+			if len(dX) > depth {
+				dX, dG = dX[1:], dG[1:]
+			}
+		}
+		xPrev, gPrev = x, g
+
+		next := clone(fx)
+		if len(dG) > 0 {
+			m := len(dG)
+			A := make([][]float64, n)
+			for k := 0; k < n; k++ {
+				A[k] = make([]float64, m)
+				for j := 0; j < m; j++ {
+					A[k][j] = dG[j][k]
+				}
+			}
+			gamma, err := svd_decompose.SolveLeastSquares(A, g, 0)
+			if err == nil {
+				for j := 0; j < m; j++ {
+					for k := 0; k < n; k++ {
+						next[k] -= (dX[j][k] + dG[j][k]) * gamma[j]
+					}
+				}
+			}
+		}
+
+		step := make([]float64, n)
+		for k := range step {
+			step[k] = next[k] - x[k]
+		}
+		x = next
+		res.History = append(res.History, Iteration{X: clone(x), StepNorm: normInf(step), FNorm: normInf(g)})
+		res.X = clone(x)
+		res.Iterations = i + 1
+	}
+	return res, nil
+}
@@ -0,0 +1,155 @@
+package nonlinear
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/KaiserRed/numeric_methods/internal/lu_decompose"
+)
+
+type BroydenUpdate int
+
+const (
+	GoodBroyden BroydenUpdate = iota // J += (y − J·s)·sᵀ / (sᵀs)
+	BadBroyden                       // J⁻¹ += (s − J⁻¹·y)·yᵀ / (yᵀy)
+)
+
+func (u BroydenUpdate) String() string {
+	switch u {
+	case GoodBroyden:
+		return "хорошее обновление"
+	case BadBroyden:
+		return "плохое обновление"
+	}
+	return fmt.Sprintf("BroydenUpdate(%d)", int(u))
+}
+
+// BroydenOptions: Refresh — через сколько итераций заново вычислять
+// якобиан (0 — только при вырождении обновления).
+type BroydenOptions struct {
+	Update  BroydenUpdate
+	Refresh int
+}
+
+// Broyden решает F(x) = 0 квазиньютоновским методом Бройдена. Обратная
+// матрица Якоби H ≈ J⁻¹ строится один раз (по sys.J или конечными
+// разностями) и далее обновляется формулой Шермана–Моррисона, так что
+// на итерацию приходится одно вычисление F.
+func Broyden(sys System, x0 []float64, params Params, opts BroydenOptions) (Result, error) {
+	if err := params.validate(); err != nil {
+		return Result{X: clone(x0)}, err
+	}
+	if sys.F == nil {
+		return Result{X: clone(x0)}, fmt.Errorf("не задана функция F")
+	}
+	if opts.Update != GoodBroyden && opts.Update != BadBroyden {
+		return Result{X: clone(x0)}, fmt.Errorf("неизвестный вид обновления %v", opts.Update)
+	}
+	if opts.Refresh < 0 {
+		return Result{X: clone(x0)}, fmt.Errorf("период обновления якобиана должен быть неотрицательным")
+	}
+
+	x := clone(x0)
+	fx := sys.F(x)
+	res := Result{X: clone(x), FEvals: 1}
+	if len(fx) != len(x) {
+		return res, fmt.Errorf("размерность F (%d) не совпадает с размерностью x (%d)", len(fx), len(x))
+	}
+
+	var H [][]float64
+	refresh := func() error {
+		J, evals := sys.jacobian(x, fx)
+		res.FEvals += evals
+		res.JEvals++
+		inv, err := lu_decompose.InverseMatrix(J)
+		if err != nil {
+			return fmt.Errorf("якобиан вырожден: %w", err)
+		}
+		H = inv
+		return nil
+	}
+
+	sinceRefresh := 0
+	for i := 0; i < params.MaxIter; i++ {
+		if H == nil || (opts.Refresh > 0 && sinceRefresh == opts.Refresh) {
+			if err := refresh(); err != nil {
+				return res, fmt.Errorf("итерация %d: %w", i+1, err)
+			}
+			sinceRefresh = 0
+		}
+
+		dx := matVec(H, fx)
+		xNext := make([]float64, len(x))
+		for k := range x {
+			dx[k] = -dx[k]
+			xNext[k] = x[k] + dx[k]
+		}
+		fNext := sys.F(xNext)
+		res.FEvals++
+		if !isFinite(xNext) || !isFinite(fNext) {
+			return res, fmt.Errorf("итерация %d: получено недопустимое значение", i+1)
+		}
+
+		y := make([]float64, len(fx))
+		for k := range fx {
+			y[k] = fNext[k] - fx[k]
+		}
+		x, fx = xNext, fNext
+		sinceRefresh++
+
+		stepNorm, fNorm := norm(dx), norm(fx)
+		res.History = append(res.History, Iteration{
+			X:        clone(x),
+			StepNorm: stepNorm,
+			FNorm:    fNorm,
+			Merit:    merit(fx),
+		})
+		res.X = clone(x)
+		res.Iterations = i + 1
+
+		if params.done(x, stepNorm, fNorm) {
+			res.Converged = true
+			return res, nil
+		}
+
+		if !updateInverse(H, dx, y, opts.Update) {
+			H = nil
+		}
+	}
+	return res, nil
+}
+
+// updateInverse обновляет H ≈ J⁻¹ по секущему условию H·y = s.
+// Возвращает false, если знаменатель обновления близок к нулю.
+func updateInverse(H [][]float64, s, y []float64, update BroydenUpdate) bool {
+	Hy := matVec(H, y)
+	u := make([]float64, len(s))
+	for i := range s {
+		u[i] = s[i] - Hy[i]
+	}
+
+	var v []float64
+	var denom float64
+	switch update {
+	case GoodBroyden:
+		// Шерман–Моррисон: H += (s − H·y)·sᵀH / (sᵀH·y)
+		v = matTVec(H, s)
+		denom = dot(s, Hy)
+		if math.Abs(denom) <= 1e-14*norm(s)*norm(Hy) {
+			return false
+		}
+	case BadBroyden:
+		v = y
+		denom = dot(y, y)
+		if denom == 0 {
+			return false
+		}
+	}
+
+	for i := range H {
+		for j := range H[i] {
+			H[i][j] += u[i] * v[j] / denom
+		}
+	}
+	return true
+}
@@ -0,0 +1,438 @@
+package nonlinear
+
+import (
+	"bufio"
+	"fmt"
+	"math"
+	"os"
+
+	"github.com/KaiserRed/numeric_methods/internal/lu_decompose"
+)
+
+// ParametricSystem описывает F(x, λ): ℝⁿ × ℝ → ℝⁿ. Jx — матрица Якоби по x,
+// Jl — производная по λ; если они не заданы, используются конечные разности.
+type ParametricSystem struct {
+	F  func(x []float64, lambda float64) []float64
+	Jx func(x []float64, lambda float64) [][]float64
+	Jl func(x []float64, lambda float64) []float64
+}
+
+type ContinuationMethod int
+
+const (
+	NaturalParameter ContinuationMethod = iota // шаг по λ, корректор по x
+	PseudoArclength                            // шаг вдоль касательной к кривой (x, λ)
+)
+
+func (m ContinuationMethod) String() string {
+	switch m {
+	case NaturalParameter:
+		return "по параметру"
+	case PseudoArclength:
+		return "по псевдодлине дуги"
+	}
+	return fmt.Sprintf("ContinuationMethod(%d)", int(m))
+}
+
+// ContinuationParams: продолжение ведётся от λ = From в сторону To и
+// прекращается, когда λ выходит за отрезок между ними. Шаг увеличивается
+// в полтора раза после быстрой сходимости корректора и делится пополам
+// после неудачи.
+type ContinuationParams struct {
+	Method   ContinuationMethod
+	From, To float64
+	Step     float64
+	MinStep  float64
+	MaxStep  float64
+	MaxSteps int
+	Newton   Params
+}
+
+type EventKind int
+
+const (
+	TurningPoint EventKind = iota // поворот ветви по λ
+	Bifurcation                   // ветвление: det J меняет знак без поворота
+)
+
+func (k EventKind) String() string {
+	switch k {
+	case TurningPoint:
+		return "точка поворота"
+	case Bifurcation:
+		return "точка бифуркации"
+	}
+	return fmt.Sprintf("EventKind(%d)", int(k))
+}
+
+// BranchPoint — точка ветви решений и определитель матрицы Якоби F по x в ней.
+type BranchPoint struct {
+	X          []float64
+	Lambda     float64
+	DetJ       float64
+	Iterations int
+	Step       float64
+}
+
+// Event — смена знака det J между точками Index−1 и Index ветви.
+type Event struct {
+	Index  int
+	Kind   EventKind
+	Lambda float64
+}
+
+type Branch struct {
+	Points []BranchPoint
+	Events []Event
+}
+
+func (p ContinuationParams) validate() error {
+	if err := p.Newton.validate(); err != nil {
+		return err
+	}
+	if p.From == p.To {
+		return fmt.Errorf("отрезок изменения параметра пуст")
+	}
+	if p.MinStep <= 0 || p.Step < p.MinStep || p.MaxStep < p.Step {
+		return fmt.Errorf("шаги должны удовлетворять 0 < MinStep <= Step <= MaxStep")
+	}
+	if p.MaxSteps <= 0 {
+		return fmt.Errorf("максимальное число шагов должно быть положительным")
+	}
+	return nil
+}
+
+func (ps ParametricSystem) jacobians(x []float64, lambda float64, fx []float64) ([][]float64, []float64) {
+	var Jx [][]float64
+	if ps.Jx != nil {
+		Jx = ps.Jx(x, lambda)
+	} else {
+		Jx = FiniteDifferenceJacobian(func(v []float64) []float64 { return ps.F(v, lambda) }, x, fx)
+	}
+	if ps.Jl != nil {
+		return Jx, ps.Jl(x, lambda)
+	}
+	h := sqrtEpsilon * math.Max(1, math.Abs(lambda))
+	fh := ps.F(x, lambda+h)
+	Jl := make([]float64, len(fx))
+	for i := range fx {
+		Jl[i] = (fh[i] - fx[i]) / h
+	}
+	return Jx, Jl
+}
+
+// Continue строит ветвь решений F(x, λ) = 0, начиная с уточнения x0
+// методом Ньютона при λ = From. Смена знака det J по x между соседними
+// точками отмечается как точка поворота, если вместе с ней меняется
+// направление движения по λ, и как бифуркация иначе. При продолжении
+// по параметру точку поворота пройти нельзя: шаг уменьшается до MinStep
+// и возвращается ошибка вместе с уже построенной частью ветви.
+func Continue(ps ParametricSystem, x0 []float64, p ContinuationParams) (Branch, error) {
+	if err := p.validate(); err != nil {
+		return Branch{}, err
+	}
+	if ps.F == nil {
+		return Branch{}, fmt.Errorf("не задана функция F")
+	}
+
+	start, err := Newton(ps.fixed(p.From), x0, p.Newton)
+	if err != nil || !start.Converged {
+		return Branch{}, fmt.Errorf("не удалось найти решение при λ = %g: %v", p.From, err)
+	}
+	var b Branch
+	b.add(ps, start.X, p.From, start.Iterations, 0)
+
+	switch p.Method {
+	case NaturalParameter:
+		err = b.natural(ps, p)
+	case PseudoArclength:
+		err = b.arclength(ps, p)
+	default:
+		err = fmt.Errorf("неизвестный метод продолжения %v", p.Method)
+	}
+	return b, err
+}
+
+// fixed возвращает систему F(·, λ) при фиксированном λ.
+func (ps ParametricSystem) fixed(lambda float64) System {
+	sys := System{F: func(x []float64) []float64 { return ps.F(x, lambda) }}
+	if ps.Jx != nil {
+		sys.J = func(x []float64) [][]float64 { return ps.Jx(x, lambda) }
+	}
+	return sys
+}
+
+func (b *Branch) add(ps ParametricSystem, x []float64, lambda float64, iterations int, step float64) {
+	fx := ps.F(x, lambda)
+	Jx, _ := ps.jacobians(x, lambda, fx)
+	b.Points = append(b.Points, BranchPoint{
+		X:          clone(x),
+		Lambda:     lambda,
+		DetJ:       determinant(Jx),
+		Iterations: iterations,
+		Step:       step,
+	})
+}
+
+// checkEvent сравнивает знаки det J в двух последних точках; turned —
+// изменилось ли направление движения по λ.
+func (b *Branch) checkEvent(turned bool) {
+	n := len(b.Points)
+	prev, cur := b.Points[n-2], b.Points[n-1]
+	if prev.DetJ*cur.DetJ >= 0 {
+		return
+	}
+	kind := Bifurcation
+	if turned {
+		kind = TurningPoint
+	}
+	// Оценка λ в точке события линейной интерполяцией det J
+	t := prev.DetJ / (prev.DetJ - cur.DetJ)
+	b.Events = append(b.Events, Event{Index: n - 1, Kind: kind, Lambda: prev.Lambda + t*(cur.Lambda-prev.Lambda)})
+}
+
+func inRange(lambda float64, p ContinuationParams) bool {
+	return lambda >= math.Min(p.From, p.To) && lambda <= math.Max(p.From, p.To)
+}
+
+func (p ContinuationParams) grow(h float64, iterations int) float64 {
+	if iterations <= 3 {
+		return math.Min(1.5*h, p.MaxStep)
+	}
+	return h
+}
+
+func (b *Branch) natural(ps ParametricSystem, p ContinuationParams) error {
+	dir := math.Copysign(1, p.To-p.From)
+	h := p.Step
+	for len(b.Points) <= p.MaxSteps {
+		last := b.Points[len(b.Points)-1]
+		if last.Lambda == p.To {
+			return nil
+		}
+		lambda := last.Lambda + dir*h
+		if !inRange(lambda, p) {
+			lambda = p.To
+		}
+
+		// Предиктор Эйлера: dx/dλ = −Jx⁻¹·Jλ
+		fx := ps.F(last.X, last.Lambda)
+		Jx, Jl := ps.jacobians(last.X, last.Lambda, fx)
+		guess := clone(last.X)
+		if dxdl, err := lu_decompose.SolveLinearSystem(Jx, Jl); err == nil {
+			for i := range guess {
+				guess[i] -= (lambda - last.Lambda) * dxdl[i]
+			}
+		}
+
+		res, err := Newton(ps.fixed(lambda), guess, p.Newton)
+		if err != nil || !res.Converged {
+			h /= 2
+			if h < p.MinStep {
+				return fmt.Errorf("шаг по параметру стал меньше минимального при λ = %g (вероятно, точка поворота)", last.Lambda)
+			}
+			continue
+		}
+		b.add(ps, res.X, lambda, res.Iterations, math.Abs(lambda-last.Lambda))
+		b.checkEvent(false)
+		h = p.grow(h, res.Iterations)
+	}
+	return nil
+}
+
+// tangent решает [Jx Jλ; t_prevᵀ]·t = [0; 1] и нормирует t.
+func tangent(Jx [][]float64, Jl, prev []float64) ([]float64, error) {
+	n := len(Jl)
+	A := make([][]float64, n+1)
+	for i := 0; i < n; i++ {
+		A[i] = append(clone(Jx[i]), Jl[i])
+	}
+	A[n] = clone(prev)
+	rhs := make([]float64, n+1)
+	rhs[n] = 1
+	t, err := lu_decompose.SolveLinearSystem(A, rhs)
+	if err != nil {
+		return nil, err
+	}
+	tn := norm(t)
+	for i := range t {
+		t[i] /= tn
+	}
+	return t, nil
+}
+
+func (b *Branch) arclength(ps ParametricSystem, p ContinuationParams) error {
+	n := len(b.Points[0].X)
+	initial := make([]float64, n+1)
+	initial[n] = math.Copysign(1, p.To-p.From)
+	t, err := b.tangentAt(ps, len(b.Points)-1, initial)
+	if err != nil {
+		return err
+	}
+	h := p.Step
+
+	for len(b.Points) <= p.MaxSteps {
+		last := b.Points[len(b.Points)-1]
+		y0 := append(clone(last.X), last.Lambda)
+		for {
+			yp := make([]float64, n+1)
+			for i := range yp {
+				yp[i] = y0[i] + h*t[i]
+			}
+			res, err := Newton(arclengthSystem(ps, t, yp), yp, p.Newton)
+			if err == nil && res.Converged && distance(res.X, yp) <= h {
+				if !inRange(res.X[n], p) {
+					b.addEnd(ps, last, res.X, t, h, p)
+					return nil
+				}
+				b.add(ps, res.X[:n], res.X[n], res.Iterations, h)
+				h = p.grow(h, res.Iterations)
+				break
+			}
+			h /= 2
+			if h < p.MinStep {
+				return fmt.Errorf("шаг по дуге стал меньше минимального при λ = %g", last.Lambda)
+			}
+		}
+
+		next, err := b.tangentAt(ps, len(b.Points)-1, t)
+		if err != nil {
+			return err
+		}
+		b.checkEvent(next[n]*t[n] < 0)
+		t = next
+	}
+	return nil
+}
+
+// addEnd завершает ветвь, когда шаг по дуге вышел за отрезок [From, To]:
+// точка y = (x, λ) переносится на пересечённый конец отрезка линейной
+// интерполяцией между last и y по λ и уточняется методом Ньютона при
+// фиксированном λ; t — касательная в last. Если уточнение не сошлось,
+// точка отбрасывается.
+func (b *Branch) addEnd(ps ParametricSystem, last BranchPoint, y, t []float64, h float64, p ContinuationParams) {
+	n := len(last.X)
+	end := p.To
+	if (y[n]-p.To)*(p.To-p.From) < 0 {
+		end = p.From
+	}
+	s := (end - last.Lambda) / (y[n] - last.Lambda)
+	if s <= 0 {
+		return
+	}
+	guess := make([]float64, n)
+	for i := range guess {
+		guess[i] = last.X[i] + s*(y[i]-last.X[i])
+	}
+	res, err := Newton(ps.fixed(end), guess, p.Newton)
+	if err != nil || !res.Converged {
+		return
+	}
+	b.add(ps, res.X, end, res.Iterations, s*h)
+	next, err := b.tangentAt(ps, len(b.Points)-1, t)
+	b.checkEvent(err == nil && next[n]*t[n] < 0)
+}
+
+// tangentAt возвращает касательную к ветви в точке с номером i,
+// ориентированную так же, как prev.
+func (b *Branch) tangentAt(ps ParametricSystem, i int, prev []float64) ([]float64, error) {
+	pt := b.Points[i]
+	Jx, Jl := ps.jacobians(pt.X, pt.Lambda, ps.F(pt.X, pt.Lambda))
+	t, err := tangent(Jx, Jl, prev)
+	if err != nil {
+		return nil, fmt.Errorf("не удалось вычислить касательную при λ = %g: %w", pt.Lambda, err)
+	}
+	return t, nil
+}
+
+// arclengthSystem — расширенная система G(x, λ) = [F(x, λ); tᵀ·(y − y_p)].
+func arclengthSystem(ps ParametricSystem, t, yp []float64) System {
+	n := len(yp) - 1
+	sys := System{F: func(y []float64) []float64 {
+		g := ps.F(y[:n], y[n])
+		s := 0.0
+		for i := range y {
+			s += t[i] * (y[i] - yp[i])
+		}
+		return append(g, s)
+	}}
+	if ps.Jx != nil {
+		sys.J = func(y []float64) [][]float64 {
+			Jx, Jl := ps.jacobians(y[:n], y[n], ps.F(y[:n], y[n]))
+			J := make([][]float64, n+1)
+			for i := 0; i < n; i++ {
+				J[i] = append(clone(Jx[i]), Jl[i])
+			}
+			J[n] = clone(t)
+			return J
+		}
+	}
+	return sys
+}
+
+func distance(a, b []float64) float64 {
+	sum := 0.0
+	for i := range a {
+		sum += (a[i] - b[i]) * (a[i] - b[i])
+	}
+	return math.Sqrt(sum)
+}
+
+// determinant вычисляет определитель методом Гаусса с выбором главного элемента.
+func determinant(A [][]float64) float64 {
+	n := len(A)
+	M := make([][]float64, n)
+	for i := range A {
+		M[i] = clone(A[i])
+	}
+	det := 1.0
+	for k := 0; k < n; k++ {
+		p := k
+		for i := k + 1; i < n; i++ {
+			if math.Abs(M[i][k]) > math.Abs(M[p][k]) {
+				p = i
+			}
+		}
+		if M[p][k] == 0 {
+			return 0
+		}
+		if p != k {
+			M[p], M[k] = M[k], M[p]
+			det = -det
+		}
+		det *= M[k][k]
+		for i := k + 1; i < n; i++ {
+			f := M[i][k] / M[k][k]
+			for j := k; j < n; j++ {
+				M[i][j] -= f * M[k][j]
+			}
+		}
+	}
+	return det
+}
+
+// WriteBranchCSV записывает ветвь в CSV: λ, x_1…x_n, det J, итерации, шаг.
+func WriteBranchCSV(filename string, b Branch) error {
+	file, err := os.Create(filename)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	w := bufio.NewWriter(file)
+	fmt.Fprint(w, "lambda")
+	if len(b.Points) > 0 {
+		for i := range b.Points[0].X {
+			fmt.Fprintf(w, ",x%d", i+1)
+		}
+	}
+	fmt.Fprintln(w, ",det_j,iterations,step")
+	for _, pt := range b.Points {
+		fmt.Fprintf(w, "%.12g", pt.Lambda)
+		for _, x := range pt.X {
+			fmt.Fprintf(w, ",%.12g", x)
+		}
+		fmt.Fprintf(w, ",%.12g,%d,%.6g\n", pt.DetJ, pt.Iterations, pt.Step)
+	}
+	return w.Flush()
+}
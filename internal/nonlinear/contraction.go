@@ -0,0 +1,213 @@
+package nonlinear
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/KaiserRed/numeric_methods/internal/lu_decompose"
+	"github.com/KaiserRed/numeric_methods/internal/root_finding"
+)
+
+// Box — прямоугольная область Lo[i] <= x[i] <= Hi[i].
+type Box struct {
+	Lo, Hi []float64
+}
+
+func (b Box) Contains(x []float64) bool {
+	for i := range x {
+		if x[i] < b.Lo[i] || x[i] > b.Hi[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// ContractionEstimate — результат анализа отображения φ на области.
+// Нормы — бесконечные: ‖v‖∞ = max|v_i|, ‖A‖∞ = max_i Σ_j |a_ij|.
+type ContractionEstimate struct {
+	Q          float64   // оценка q = max‖φ'(x)‖∞
+	WorstPoint []float64 // точка, в которой достигается максимум
+	ImageLo    []float64 // покоординатные границы образа φ по выборке
+	ImageHi    []float64
+	// SelfMapping — φ(Box) ⊂ Box
+	SelfMapping bool
+	Samples     int
+}
+
+// IsContraction и NearUnity используют тот же запас, что и скалярный
+// анализ в root_finding.
+func (c ContractionEstimate) IsContraction() bool {
+	return root_finding.ContractionProved(c.Q) && c.SelfMapping
+}
+
+func (c ContractionEstimate) NearUnity() bool {
+	return root_finding.QNearUnity(c.Q)
+}
+
+func normInf(v []float64) float64 {
+	m := 0.0
+	for _, x := range v {
+		m = math.Max(m, math.Abs(x))
+	}
+	return m
+}
+
+func matrixNormInf(A [][]float64) float64 {
+	m := 0.0
+	for _, row := range A {
+		sum := 0.0
+		for _, a := range row {
+			sum += math.Abs(a)
+		}
+		m = math.Max(m, sum)
+	}
+	return m
+}
+
+// AnalyzeContraction оценивает q = max‖φ'(x)‖∞ и образ φ(box) на
+// равномерной сетке из perDim точек по каждой координате. Если dphi
+// не задана, матрица Якоби φ вычисляется конечными разностями.
+func AnalyzeContraction(phi func([]float64) []float64, dphi func([]float64) [][]float64, box Box, perDim int) (ContractionEstimate, error) {
+	n := len(box.Lo)
+	if n == 0 || len(box.Hi) != n {
+		return ContractionEstimate{}, fmt.Errorf("границы области имеют разную размерность")
+	}
+	for i := 0; i < n; i++ {
+		if box.Lo[i] >= box.Hi[i] {
+			return ContractionEstimate{}, fmt.Errorf("пустая область по координате %d", i+1)
+		}
+	}
+	if perDim < 2 {
+		return ContractionEstimate{}, fmt.Errorf("требуется хотя бы 2 точки по каждой координате")
+	}
+
+	c := ContractionEstimate{
+		ImageLo: make([]float64, n),
+		ImageHi: make([]float64, n),
+	}
+	for i := 0; i < n; i++ {
+		c.ImageLo[i], c.ImageHi[i] = math.Inf(1), math.Inf(-1)
+	}
+
+	idx := make([]int, n)
+	x := make([]float64, n)
+	for {
+		for i := 0; i < n; i++ {
+			x[i] = box.Lo[i] + (box.Hi[i]-box.Lo[i])*float64(idx[i])/float64(perDim-1)
+		}
+		y := phi(x)
+		if !isFinite(y) {
+			return c, fmt.Errorf("φ не определена в точке %v", x)
+		}
+		var J [][]float64
+		if dphi != nil {
+			J = dphi(x)
+		} else {
+			J = FiniteDifferenceJacobian(phi, x, y)
+		}
+		q := matrixNormInf(J)
+		if math.IsNaN(q) || math.IsInf(q, 0) {
+			return c, fmt.Errorf("матрица Якоби φ не определена в точке %v", x)
+		}
+		if c.Samples == 0 || q > c.Q {
+			c.Q, c.WorstPoint = q, clone(x)
+		}
+		for i := 0; i < n; i++ {
+			c.ImageLo[i] = math.Min(c.ImageLo[i], y[i])
+			c.ImageHi[i] = math.Max(c.ImageHi[i], y[i])
+		}
+		c.Samples++
+
+		k := 0
+		for ; k < n; k++ {
+			idx[k]++
+			if idx[k] < perDim {
+				break
+			}
+			idx[k] = 0
+		}
+		if k == n {
+			break
+		}
+	}
+	c.SelfMapping = box.Contains(c.ImageLo) && box.Contains(c.ImageHi)
+	return c, nil
+}
+
+// Relaxation строит φ(x) = x − Λ·F(x) с Λ = J(x0)⁻¹ и её матрицу Якоби
+// φ'(x) = I − Λ·J(x).
+func Relaxation(sys System, x0 []float64) (phi func([]float64) []float64, dphi func([]float64) [][]float64, Lambda [][]float64, err error) {
+	if sys.F == nil {
+		return nil, nil, nil, fmt.Errorf("не задана функция F")
+	}
+	J0, _ := sys.jacobian(x0, sys.F(x0))
+	Lambda, err = lu_decompose.InverseMatrix(J0)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("якобиан в начальной точке вырожден: %w", err)
+	}
+
+	phi = func(x []float64) []float64 {
+		step := matVec(Lambda, sys.F(x))
+		y := clone(x)
+		for i := range y {
+			y[i] -= step[i]
+		}
+		return y
+	}
+	dphi = func(x []float64) [][]float64 {
+		J, _ := sys.jacobian(x, sys.F(x))
+		n := len(x)
+		D := make([][]float64, n)
+		for i := 0; i < n; i++ {
+			D[i] = make([]float64, n)
+			for j := 0; j < n; j++ {
+				for k := 0; k < n; k++ {
+					D[i][j] -= Lambda[i][k] * J[k][j]
+				}
+			}
+			D[i][i] += 1
+		}
+		return D
+	}
+	return phi, dphi, Lambda, nil
+}
+
+// FixedPoint — метод простой итерации x_{k+1} = φ(x_k). При 0 <= q < 1
+// остановка производится по апостериорной оценке
+// ‖x_k − x*‖∞ <= q/(1 − q)·‖x_k − x_{k−1}‖∞, иначе — по норме шага.
+func FixedPoint(phi func([]float64) []float64, x0 []float64, q float64, params Params) (Result, error) {
+	if err := params.validate(); err != nil {
+		return Result{X: clone(x0)}, err
+	}
+
+	contraction := q >= 0 && q < 1
+	x := clone(x0)
+	res := Result{X: clone(x)}
+	for i := 0; i < params.MaxIter; i++ {
+		xNext := phi(x)
+		res.FEvals++
+		if !isFinite(xNext) {
+			return res, fmt.Errorf("итерация %d: получено недопустимое значение", i+1)
+		}
+
+		step := make([]float64, len(x))
+		for k := range x {
+			step[k] = xNext[k] - x[k]
+		}
+		stepNorm := normInf(step)
+		bound := stepNorm
+		if contraction {
+			bound = q / (1 - q) * stepNorm
+		}
+		x = xNext
+		res.History = append(res.History, Iteration{X: clone(x), StepNorm: stepNorm, Bound: bound})
+		res.X = clone(x)
+		res.Iterations = i + 1
+
+		if bound <= params.AbsTol+params.RelTol*normInf(x) {
+			res.Converged = true
+			return res, nil
+		}
+	}
+	return res, nil
+}
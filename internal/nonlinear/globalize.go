@@ -0,0 +1,255 @@
+package nonlinear
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/KaiserRed/numeric_methods/internal/lu_decompose"
+)
+
+type Strategy int
+
+const (
+	FullStep           Strategy = iota // чистый метод Ньютона
+	LineSearch                         // дробление шага по правилу Армихо
+	TrustRegion                        // доверительная область, шаг dogleg
+	LevenbergMarquardt                 // демпфирование (JᵀJ + μI)·Δx = −JᵀF
+)
+
+func (s Strategy) String() string {
+	switch s {
+	case FullStep:
+		return "полный шаг"
+	case LineSearch:
+		return "линейный поиск"
+	case TrustRegion:
+		return "доверительная область"
+	case LevenbergMarquardt:
+		return "Левенберг–Марквардт"
+	}
+	return fmt.Sprintf("Strategy(%d)", int(s))
+}
+
+const (
+	armijoC       = 1e-4
+	minStep       = 1e-10
+	maxTrials     = 50
+	acceptRatio   = 1e-4
+	minTrustRatio = 1e-14
+)
+
+// globalizer хранит состояние стратегии между итерациями:
+// радиус доверительной области или параметры μ, ν.
+type globalizer struct {
+	sys      System
+	strategy Strategy
+	radius   float64
+	mu, nu   float64
+	fEvals   int
+}
+
+// trial — принятый шаг: приращение, новая точка, F в ней и параметр демпфирования.
+type trial struct {
+	dx, x, fx []float64
+	damping   float64
+}
+
+func newGlobalizer(sys System, strategy Strategy, x0 []float64) *globalizer {
+	return &globalizer{
+		sys:      sys,
+		strategy: strategy,
+		radius:   math.Max(1, norm(x0)),
+		nu:       2,
+	}
+}
+
+func (g *globalizer) eval(x, dx []float64, t float64) ([]float64, []float64) {
+	xt := make([]float64, len(x))
+	for i := range x {
+		xt[i] = x[i] + t*dx[i]
+	}
+	g.fEvals++
+	return xt, g.sys.F(xt)
+}
+
+// predicted — ожидаемое по линейной модели уменьшение ½‖F‖²
+func predicted(J [][]float64, fx, p []float64) float64 {
+	model := matVec(J, p)
+	for i := range model {
+		model[i] += fx[i]
+	}
+	return merit(fx) - merit(model)
+}
+
+func newtonStep(J [][]float64, fx []float64) ([]float64, error) {
+	rhs := make([]float64, len(fx))
+	for i := range fx {
+		rhs[i] = -fx[i]
+	}
+	return lu_decompose.SolveLinearSystem(J, rhs)
+}
+
+func (g *globalizer) step(x, fx []float64, J [][]float64) (trial, error) {
+	switch g.strategy {
+	case FullStep:
+		dx, err := newtonStep(J, fx)
+		if err != nil {
+			return trial{}, fmt.Errorf("якобиан вырожден: %w", err)
+		}
+		xt, ft := g.eval(x, dx, 1)
+		return trial{dx: dx, x: xt, fx: ft, damping: 1}, nil
+	case LineSearch:
+		return g.lineSearch(x, fx, J)
+	case TrustRegion:
+		return g.dogleg(x, fx, J)
+	case LevenbergMarquardt:
+		return g.levenbergMarquardt(x, fx, J)
+	}
+	return trial{}, fmt.Errorf("неизвестная стратегия %v", g.strategy)
+}
+
+// lineSearch дробит ньютоновский шаг, пока не выполнено условие Армихо
+// φ(t) <= (1 − 2c·t)·φ(0) для φ(t) = ½‖F(x + t·Δx)‖². Новая длина шага
+// берётся из минимума квадратичной интерполяции, ограниченного [0.1t, 0.5t].
+func (g *globalizer) lineSearch(x, fx []float64, J [][]float64) (trial, error) {
+	dx, err := newtonStep(J, fx)
+	if err != nil {
+		return trial{}, fmt.Errorf("якобиан вырожден: %w", err)
+	}
+	m0 := merit(fx)
+	slope := -2 * m0
+	for t := 1.0; t >= minStep; {
+		xt, ft := g.eval(x, dx, t)
+		if !isFinite(ft) {
+			t *= 0.1
+			continue
+		}
+		mt := merit(ft)
+		if mt <= m0+armijoC*t*slope {
+			for i := range dx {
+				dx[i] *= t
+			}
+			return trial{dx: dx, x: xt, fx: ft, damping: t}, nil
+		}
+		tq := -slope * t * t / (2 * (mt - m0 - slope*t))
+		t = math.Min(math.Max(tq, 0.1*t), 0.5*t)
+	}
+	return trial{}, fmt.Errorf("линейный поиск не нашёл шага, уменьшающего невязку")
+}
+
+// dogleg выбирает шаг на ломаной от начала через точку Коши к ньютоновскому
+// шагу внутри доверительной области и меняет её радиус по отношению
+// фактического уменьшения ½‖F‖² к предсказанному.
+func (g *globalizer) dogleg(x, fx []float64, J [][]float64) (trial, error) {
+	grad := matTVec(J, fx)
+	gg := dot(grad, grad)
+	if gg == 0 {
+		return trial{}, fmt.Errorf("градиент ½‖F‖² равен нулю: локальный минимум невязки")
+	}
+	Jg := matVec(J, grad)
+	cauchy := make([]float64, len(grad))
+	for i := range grad {
+		cauchy[i] = -gg / dot(Jg, Jg) * grad[i]
+	}
+	newton, newtonErr := newtonStep(J, fx)
+
+	m0 := merit(fx)
+	for k := 0; k < maxTrials; k++ {
+		p := doglegPoint(cauchy, newton, newtonErr == nil, g.radius)
+		pNorm := norm(p)
+		xt, ft := g.eval(x, p, 1)
+
+		rho := -1.0
+		if isFinite(ft) {
+			rho = (m0 - merit(ft)) / predicted(J, fx, p)
+		}
+		if rho < 0.25 {
+			g.radius = 0.25 * pNorm
+		} else if rho > 0.75 && pNorm >= 0.99*g.radius {
+			g.radius *= 2
+		}
+		if rho > acceptRatio {
+			return trial{dx: p, x: xt, fx: ft, damping: g.radius}, nil
+		}
+		if g.radius < minTrustRatio*(1+norm(x)) {
+			break
+		}
+	}
+	return trial{}, fmt.Errorf("радиус доверительной области стал слишком мал")
+}
+
+func doglegPoint(cauchy, newton []float64, hasNewton bool, radius float64) []float64 {
+	if hasNewton && norm(newton) <= radius {
+		return clone(newton)
+	}
+	cNorm := norm(cauchy)
+	if !hasNewton || cNorm >= radius {
+		p := clone(cauchy)
+		for i := range p {
+			p[i] *= radius / cNorm
+		}
+		return p
+	}
+	// ‖c + τ(n − c)‖ = radius, τ ∈ [0, 1]
+	d := make([]float64, len(cauchy))
+	for i := range d {
+		d[i] = newton[i] - cauchy[i]
+	}
+	a, b, c := dot(d, d), 2*dot(cauchy, d), cNorm*cNorm-radius*radius
+	tau := (-b + math.Sqrt(b*b-4*a*c)) / (2 * a)
+	p := clone(cauchy)
+	for i := range p {
+		p[i] += tau * d[i]
+	}
+	return p
+}
+
+// levenbergMarquardt решает (JᵀJ + μI)·Δx = −JᵀF и обновляет μ по схеме
+// Нильсена: при удачном шаге μ уменьшается, при неудачном растёт в ν раз.
+func (g *globalizer) levenbergMarquardt(x, fx []float64, J [][]float64) (trial, error) {
+	n := len(x)
+	A := make([][]float64, n)
+	maxDiag := 0.0
+	for i := 0; i < n; i++ {
+		A[i] = make([]float64, n)
+		for j := 0; j < n; j++ {
+			for k := range J {
+				A[i][j] += J[k][i] * J[k][j]
+			}
+		}
+		maxDiag = math.Max(maxDiag, A[i][i])
+	}
+	if g.mu == 0 {
+		g.mu = 1e-3 * math.Max(maxDiag, 1)
+	}
+	grad := matTVec(J, fx)
+	rhs := make([]float64, n)
+	for i := range grad {
+		rhs[i] = -grad[i]
+	}
+
+	m0 := merit(fx)
+	for k := 0; k < maxTrials; k++ {
+		M := make([][]float64, n)
+		for i := range M {
+			M[i] = clone(A[i])
+			M[i][i] += g.mu
+		}
+		p, err := lu_decompose.SolveLinearSystem(M, rhs)
+		if err == nil {
+			xt, ft := g.eval(x, p, 1)
+			rho := -1.0
+			if isFinite(ft) {
+				rho = (m0 - merit(ft)) / predicted(J, fx, p)
+			}
+			if rho > acceptRatio {
+				g.mu *= math.Max(1.0/3, 1-math.Pow(2*rho-1, 3))
+				g.nu = 2
+				return trial{dx: p, x: xt, fx: ft, damping: g.mu}, nil
+			}
+		}
+		g.mu *= g.nu
+		g.nu *= 2
+	}
+	return trial{}, fmt.Errorf("не удалось подобрать параметр демпфирования")
+}
@@ -0,0 +1,134 @@
+package nonlinear
+
+import (
+	"fmt"
+	"math"
+)
+
+// GMRESResult — результат решения линейной системы методом GMRES.
+// Residual — достигнутая относительная невязка ‖b − A·x‖/‖b‖.
+type GMRESResult struct {
+	X          []float64
+	Iterations int
+	Residual   float64
+	Converged  bool
+}
+
+// GMRES решает A·x = b перезапускаемым методом обобщённых минимальных
+// невязок GMRES(restart) с нулевым начальным приближением. Матрица задаётся
+// только произведением A·v. Если precond не nil, он применяет M⁻¹ к вектору
+// (правое предобусловливание A·M⁻¹·y = b, x = M⁻¹·y), и невязка
+// предобусловливанием не искажается. Итерации прекращаются, когда
+// ‖b − A·x‖ <= tol·‖b‖ или выполнено maxIter шагов Арнольди.
+func GMRES(A func([]float64) []float64, b []float64, precond func([]float64) []float64, tol float64, restart, maxIter int) (GMRESResult, error) {
+	if restart <= 0 || maxIter <= 0 {
+		return GMRESResult{}, fmt.Errorf("число шагов GMRES должно быть положительным")
+	}
+	if precond == nil {
+		precond = func(v []float64) []float64 { return v }
+	}
+
+	n := len(b)
+	res := GMRESResult{X: make([]float64, n), Residual: 1}
+	bNorm := norm(b)
+	if bNorm == 0 {
+		res.Residual, res.Converged = 0, true
+		return res, nil
+	}
+
+	r := clone(b)
+	for res.Iterations < maxIter {
+		beta := norm(r)
+		res.Residual = beta / bNorm
+		if res.Residual <= tol {
+			res.Converged = true
+			return res, nil
+		}
+
+		// Базис Крылова V, матрица Хессенберга H (по столбцам), вращения Гивенса
+		V := [][]float64{scale(r, 1/beta)}
+		H := make([][]float64, 0, restart)
+		cs, sn := make([]float64, 0, restart), make([]float64, 0, restart)
+		g := []float64{beta}
+
+		for j := 0; j < restart && res.Iterations < maxIter; j++ {
+			w := A(precond(V[j]))
+			h := make([]float64, j+2)
+			for i := 0; i <= j; i++ {
+				h[i] = dot(w, V[i])
+				for k := range w {
+					w[k] -= h[i] * V[i][k]
+				}
+			}
+			h[j+1] = norm(w)
+
+			for i := 0; i < j; i++ {
+				h[i], h[i+1] = cs[i]*h[i]+sn[i]*h[i+1], -sn[i]*h[i]+cs[i]*h[i+1]
+			}
+			rho := math.Hypot(h[j], h[j+1])
+			c, s := 1.0, 0.0
+			if rho != 0 {
+				c, s = h[j]/rho, h[j+1]/rho
+			}
+			cs, sn = append(cs, c), append(sn, s)
+			breakdown := h[j+1] == 0
+			if !breakdown {
+				V = append(V, scale(w, 1/h[j+1]))
+			}
+			h[j], h[j+1] = rho, 0
+			H = append(H, h)
+			g = append(g, -s*g[j])
+			g[j] *= c
+
+			res.Iterations++
+			res.Residual = math.Abs(g[j+1]) / bNorm
+			if breakdown || res.Residual <= tol {
+				break
+			}
+		}
+
+		// Обратный ход для треугольной системы R·y = g и x += M⁻¹·V·y
+		k := len(H)
+		y := make([]float64, k)
+		for i := k - 1; i >= 0; i-- {
+			sum := g[i]
+			for l := i + 1; l < k; l++ {
+				sum -= H[l][i] * y[l]
+			}
+			if H[i][i] == 0 {
+				return res, fmt.Errorf("вырожденная матрица Хессенберга на шаге %d", res.Iterations)
+			}
+			y[i] = sum / H[i][i]
+		}
+		u := make([]float64, n)
+		for i := 0; i < k; i++ {
+			for l := range u {
+				u[l] += y[i] * V[i][l]
+			}
+		}
+		dx := precond(u)
+		for l := range res.X {
+			res.X[l] += dx[l]
+		}
+
+		// Истинная невязка для перезапуска и окончательной оценки
+		ax := A(res.X)
+		for l := range r {
+			r[l] = b[l] - ax[l]
+		}
+		res.Residual = norm(r) / bNorm
+		if res.Residual <= tol {
+			res.Converged = true
+			return res, nil
+		}
+	}
+	return res, nil
+}
+
+func scale(v []float64, a float64) []float64 {
+	out := make([]float64, len(v))
+	for i := range v {
+		out[i] = a * v[i]
+	}
+	return out
+}
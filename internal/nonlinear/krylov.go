@@ -0,0 +1,214 @@
+package nonlinear
+
+import (
+	"fmt"
+	"math"
+)
+
+// ForcingTerm — правило выбора параметра η_k неточного метода Ньютона:
+// линейная система решается до ‖F + J·s‖ <= η_k·‖F‖.
+type ForcingTerm int
+
+const (
+	ConstantForcing  ForcingTerm = iota // η_k = Eta
+	EisenstatWalker1                    // η_k = |‖F_k‖ − ‖F_{k−1} + J_{k−1}·s_{k−1}‖| / ‖F_{k−1}‖
+	EisenstatWalker2                    // η_k = γ·(‖F_k‖/‖F_{k−1}‖)^α, γ = 0.9, α = 2
+)
+
+func (f ForcingTerm) String() string {
+	switch f {
+	case ConstantForcing:
+		return "постоянный η"
+	case EisenstatWalker1:
+		return "Айзенштат–Уокер, вариант 1"
+	case EisenstatWalker2:
+		return "Айзенштат–Уокер, вариант 2"
+	}
+	return fmt.Sprintf("ForcingTerm(%d)", int(f))
+}
+
+// Preconditioner строит по текущему приближению x и F(x) оператор,
+// применяющий M⁻¹ ≈ J(x)⁻¹ к вектору. Вызывается один раз за внешнюю
+// итерацию, так что дорогую подготовку (факторизацию) можно делать в нём.
+type Preconditioner func(x, fx []float64) func(v []float64) []float64
+
+// KrylovOptions — настройки метода Ньютона–Крылова. Нулевые значения
+// заменяются умолчаниями: Eta = 0.5 (начальное η), EtaMax = 0.9,
+// Restart = 30, MaxLinear = 10·Restart внутренних итераций на шаг.
+type KrylovOptions struct {
+	Forcing        ForcingTerm
+	Eta            float64
+	EtaMax         float64
+	Restart        int
+	MaxLinear      int
+	Preconditioner Preconditioner
+}
+
+const (
+	ewGamma = 0.9
+	ewAlpha = 2
+
+	// Порог, выше которого η_k не может резко уменьшиться (защита от
+	// излишне точного решения вдали от корня)
+	ewSafeguard  = 0.1
+	maxBacktrack = 30
+)
+
+func (o *KrylovOptions) setDefaults() error {
+	if o.Eta == 0 {
+		o.Eta = 0.5
+	}
+	if o.EtaMax == 0 {
+		o.EtaMax = 0.9
+	}
+	if o.Restart == 0 {
+		o.Restart = 30
+	}
+	if o.MaxLinear == 0 {
+		o.MaxLinear = 10 * o.Restart
+	}
+	if o.Eta < 0 || o.Eta >= 1 || o.EtaMax <= 0 || o.EtaMax >= 1 {
+		return fmt.Errorf("параметры η должны лежать в (0, 1)")
+	}
+	if o.Restart < 0 || o.MaxLinear < 0 {
+		return fmt.Errorf("число внутренних итераций должно быть положительным")
+	}
+	if o.Forcing < ConstantForcing || o.Forcing > EisenstatWalker2 {
+		return fmt.Errorf("неизвестное правило выбора η: %v", o.Forcing)
+	}
+	return nil
+}
+
+// NewtonKrylov решает F(x) = 0 безматричным неточным методом Ньютона:
+// матрица Якоби не строится, произведение J·v приближается разностью
+// (F(x + h·v) − F(x))/h, а система J·s = −F решается методом GMRES с
+// точностью η_k. Шаг глобализуется дроблением до выполнения условия
+// ‖F(x + λs)‖ <= (1 − 10⁻⁴·λ·(1 − η))·‖F(x)‖.
+func NewtonKrylov(F func([]float64) []float64, x0 []float64, params Params, opts KrylovOptions) (Result, error) {
+	if err := params.validate(); err != nil {
+		return Result{X: clone(x0)}, err
+	}
+	if F == nil {
+		return Result{X: clone(x0)}, fmt.Errorf("не задана функция F")
+	}
+	if err := opts.setDefaults(); err != nil {
+		return Result{X: clone(x0)}, err
+	}
+
+	x := clone(x0)
+	fx := F(x)
+	res := Result{X: clone(x), FEvals: 1}
+	if len(fx) != len(x) {
+		return res, fmt.Errorf("размерность F (%d) не совпадает с размерностью x (%d)", len(fx), len(x))
+	}
+	fNorm := norm(fx)
+	if fNorm <= params.FTol {
+		res.Converged = true
+		return res, nil
+	}
+
+	eta := opts.Eta
+	rhs := make([]float64, len(fx))
+	for i := 0; i < params.MaxIter; i++ {
+		xNorm := norm(x)
+		Jv := func(v []float64) []float64 {
+			vNorm := norm(v)
+			out := make([]float64, len(v))
+			if vNorm == 0 {
+				return out
+			}
+			h := sqrtEpsilon * math.Max(1, xNorm) / vNorm
+			xh := make([]float64, len(x))
+			for k := range x {
+				xh[k] = x[k] + h*v[k]
+			}
+			fh := F(xh)
+			res.FEvals++
+			for k := range out {
+				out[k] = (fh[k] - fx[k]) / h
+			}
+			return out
+		}
+		var precond func([]float64) []float64
+		if opts.Preconditioner != nil {
+			precond = opts.Preconditioner(x, fx)
+		}
+
+		for k := range fx {
+			rhs[k] = -fx[k]
+		}
+		lin, err := GMRES(Jv, rhs, precond, eta, opts.Restart, opts.MaxLinear)
+		res.LinearIterations += lin.Iterations
+		if err != nil {
+			return res, fmt.Errorf("итерация %d: %w", i+1, err)
+		}
+		s := lin.X
+
+		// Неточный шаг с дроблением: при уменьшении λ условие на η ослабляется
+		lambda, etaUsed := 1.0, eta
+		var xNew, fNew []float64
+		for trial := 0; ; trial++ {
+			if trial == maxBacktrack {
+				return res, fmt.Errorf("итерация %d: не удалось уменьшить ‖F‖ дроблением шага", i+1)
+			}
+			xNew = make([]float64, len(x))
+			for k := range x {
+				xNew[k] = x[k] + lambda*s[k]
+			}
+			fNew = F(xNew)
+			res.FEvals++
+			if isFinite(fNew) && norm(fNew) <= (1-armijoC*(1-etaUsed))*fNorm {
+				break
+			}
+			lambda /= 2
+			etaUsed = 1 - (1-etaUsed)/2
+		}
+
+		// Норма линейной невязки для первого варианта Айзенштата–Уокера
+		linResidual := (1-lambda)*fNorm + lambda*lin.Residual*fNorm
+		fNormNew := norm(fNew)
+		stepNorm := lambda * norm(s)
+		res.History = append(res.History, Iteration{
+			X:                clone(xNew),
+			StepNorm:         stepNorm,
+			FNorm:            fNormNew,
+			Merit:            fNormNew * fNormNew / 2,
+			Damping:          lambda,
+			Forcing:          eta,
+			LinearIterations: lin.Iterations,
+		})
+		res.X = clone(xNew)
+		res.Iterations = i + 1
+
+		eta = nextForcing(opts, eta, fNorm, fNormNew, linResidual, params.FTol)
+		x, fx, fNorm = xNew, fNew, fNormNew
+		if params.done(x, stepNorm, fNorm) {
+			res.Converged = true
+			return res, nil
+		}
+	}
+	return res, nil
+}
+
+// nextForcing вычисляет η_{k+1} с защитой Айзенштата–Уокера от резкого
+// уменьшения и от излишне точного решения вблизи корня.
+func nextForcing(opts KrylovOptions, eta, fNorm, fNormNew, linResidual, fTol float64) float64 {
+	var next, safeguard float64
+	switch opts.Forcing {
+	case ConstantForcing:
+		return eta
+	case EisenstatWalker1:
+		next = math.Abs(fNormNew-linResidual) / fNorm
+		safeguard = math.Pow(eta, (1+math.Sqrt(5))/2)
+	case EisenstatWalker2:
+		next = ewGamma * math.Pow(fNormNew/fNorm, ewAlpha)
+		safeguard = ewGamma * math.Pow(eta, ewAlpha)
+	}
+	if safeguard > ewSafeguard {
+		next = math.Max(next, safeguard)
+	}
+	if fTol > 0 && fNormNew > 0 {
+		next = math.Max(next, fTol/(2*fNormNew))
+	}
+	return math.Min(next, opts.EtaMax)
+}
@@ -0,0 +1,84 @@
+package nonlinear
+
+import "fmt"
+
+// Newton решает F(x) = 0 методом Ньютона; на каждом шаге система
+// J(x)·Δx = −F(x) решается LU-разложением, а шаг при необходимости
+// корректируется стратегией params.Strategy. Функция не использует
+// разделяемого состояния и безопасна для параллельного вызова.
+func Newton(sys System, x0 []float64, params Params) (Result, error) {
+	if err := params.validate(); err != nil {
+		return Result{X: clone(x0)}, err
+	}
+	if sys.F == nil {
+		return Result{X: clone(x0)}, fmt.Errorf("не задана функция F")
+	}
+
+	x := clone(x0)
+	fx := sys.F(x)
+	res := Result{X: clone(x), FEvals: 1}
+	if len(fx) != len(x) {
+		return res, fmt.Errorf("размерность F (%d) не совпадает с размерностью x (%d)", len(fx), len(x))
+	}
+
+	g := newGlobalizer(sys, params.Strategy, x)
+	var J [][]float64
+	for i := 0; i < params.MaxIter; i++ {
+		if J == nil {
+			var evals int
+			J, evals = sys.jacobian(x, fx)
+			res.FEvals += evals
+			res.JEvals++
+		}
+
+		t, err := g.step(x, fx, J)
+		res.FEvals += g.fEvals
+		g.fEvals = 0
+		if err != nil {
+			return res, fmt.Errorf("итерация %d: %w", i+1, err)
+		}
+		x, fx, J = t.x, t.fx, nil
+		if !isFinite(x) || !isFinite(fx) {
+			return res, fmt.Errorf("итерация %d: получено недопустимое значение", i+1)
+		}
+
+		stepNorm, fNorm := norm(t.dx), norm(fx)
+		res.History = append(res.History, Iteration{
+			X:        clone(x),
+			StepNorm: stepNorm,
+			FNorm:    fNorm,
+			Merit:    merit(fx),
+			Damping:  t.damping,
+		})
+		res.X = clone(x)
+		res.Iterations = i + 1
+
+		if !params.done(x, stepNorm, fNorm) {
+			continue
+		}
+		if params.Strategy == FullStep {
+			res.Converged = true
+			return res, nil
+		}
+		// Укороченный шаг глобализации может быть мал и вдали от корня,
+		// например в локальном минимуме ½‖F‖². Сходимость подтверждается
+		// полным ньютоновским шагом из новой точки; якобиан в ней нужен и
+		// для следующей итерации, если она понадобится.
+		var evals int
+		J, evals = sys.jacobian(x, fx)
+		res.FEvals += evals
+		res.JEvals++
+		if newtonStepSmall(J, x, fx, params) {
+			res.Converged = true
+			return res, nil
+		}
+	}
+	return res, nil
+}
+
+// newtonStepSmall проверяет, что полный ньютоновский шаг −J⁻¹·F из точки x
+// (J и F вычислены в x) удовлетворяет критерию остановки.
+func newtonStepSmall(J [][]float64, x, fx []float64, params Params) bool {
+	dx, err := newtonStep(J, fx)
+	return err == nil && params.done(x, norm(dx), norm(fx))
+}
@@ -0,0 +1,159 @@
+package nonlinear
+
+import (
+	"fmt"
+	"math"
+)
+
+// Params — настройки решателей систем. Итерации останавливаются, когда
+// ‖Δx‖ <= AbsTol + RelTol·‖x‖ или ‖F(x)‖ <= FTol.
+type Params struct {
+	AbsTol  float64
+	RelTol  float64
+	FTol    float64
+	MaxIter int
+
+	// Strategy — способ глобализации шага метода Ньютона
+	Strategy Strategy
+}
+
+// System описывает F: ℝⁿ → ℝⁿ. Если J не задан, матрица Якоби
+// вычисляется конечными разностями.
+type System struct {
+	F func(x []float64) []float64
+	J func(x []float64) [][]float64
+}
+
+// Iteration — запись истории: приближение, норма шага, норма невязки и
+// значение функции качества ½‖F‖². Damping — длина шага при линейном
+// поиске, радиус доверительной области или параметр μ метода
+// Левенберга–Марквардта. Bound — апостериорная оценка погрешности
+// метода простой итерации. Forcing и LinearIterations — параметр η и
+// число внутренних итераций метода Ньютона–Крылова.
+type Iteration struct {
+	X        []float64
+	StepNorm float64
+	FNorm    float64
+	Merit    float64
+	Damping  float64
+	Bound    float64
+
+	Forcing          float64
+	LinearIterations int
+}
+
+type Result struct {
+	X          []float64
+	Iterations int
+	History    []Iteration
+	Converged  bool
+	FEvals     int
+	JEvals     int
+
+	// LinearIterations — суммарное число внутренних итераций
+	LinearIterations int
+}
+
+func (p Params) validate() error {
+	if p.AbsTol < 0 || p.RelTol < 0 || p.FTol < 0 {
+		return fmt.Errorf("допуски должны быть неотрицательными")
+	}
+	if p.AbsTol == 0 && p.RelTol == 0 && p.FTol == 0 {
+		return fmt.Errorf("должен быть задан хотя бы один допуск")
+	}
+	if p.MaxIter <= 0 {
+		return fmt.Errorf("максимальное число итераций должно быть положительным")
+	}
+	return nil
+}
+
+func (p Params) done(x []float64, stepNorm, fNorm float64) bool {
+	return stepNorm <= p.AbsTol+p.RelTol*norm(x) || fNorm <= p.FTol
+}
+
+// √ε для float64 — множитель шага конечных разностей
+const sqrtEpsilon = 1.4901161193847656e-08
+
+func norm(v []float64) float64 {
+	sum := 0.0
+	for _, x := range v {
+		sum += x * x
+	}
+	return math.Sqrt(sum)
+}
+
+func isFinite(v []float64) bool {
+	for _, x := range v {
+		if math.IsNaN(x) || math.IsInf(x, 0) {
+			return false
+		}
+	}
+	return true
+}
+
+func dot(a, b []float64) float64 {
+	sum := 0.0
+	for i := range a {
+		sum += a[i] * b[i]
+	}
+	return sum
+}
+
+// merit — функция качества ½‖F‖²
+func merit(fx []float64) float64 {
+	return dot(fx, fx) / 2
+}
+
+// J·v
+func matVec(J [][]float64, v []float64) []float64 {
+	r := make([]float64, len(J))
+	for i := range J {
+		r[i] = dot(J[i], v)
+	}
+	return r
+}
+
+// Jᵀ·v
+func matTVec(J [][]float64, v []float64) []float64 {
+	r := make([]float64, len(J[0]))
+	for i := range J {
+		for j := range r {
+			r[j] += J[i][j] * v[i]
+		}
+	}
+	return r
+}
+
+func clone(v []float64) []float64 {
+	return append([]float64(nil), v...)
+}
+
+// FiniteDifferenceJacobian вычисляет матрицу Якоби правыми разностями
+// с шагом h_j = √ε·max(1, |x_j|). fx — уже вычисленное значение F(x).
+func FiniteDifferenceJacobian(F func([]float64) []float64, x, fx []float64) [][]float64 {
+	n := len(x)
+	J := make([][]float64, len(fx))
+	for i := range J {
+		J[i] = make([]float64, n)
+	}
+	xh := clone(x)
+	for j := 0; j < n; j++ {
+		h := sqrtEpsilon * math.Max(1, math.Abs(x[j]))
+		xh[j] = x[j] + h
+		h = xh[j] - x[j]
+		fh := F(xh)
+		for i := range fx {
+			J[i][j] = (fh[i] - fx[i]) / h
+		}
+		xh[j] = x[j]
+	}
+	return J
+}
+
+// jacobian возвращает матрицу Якоби и число вычислений F, затраченных на неё.
+func (sys System) jacobian(x, fx []float64) ([][]float64, int) {
+	if sys.J != nil {
+		return sys.J(x), 0
+	}
+	return FiniteDifferenceJacobian(sys.F, x, fx), len(x)
+}
@@ -0,0 +1,86 @@
+package root_finding
+
+import (
+	"fmt"
+	"math"
+)
+
+// aitken возвращает экстраполяцию Эйткена x0 − (x1 − x0)²/(x2 − 2x1 + x0)
+// или x2, если знаменатель равен нулю.
+func aitken(x0, x1, x2 float64) float64 {
+	denom := x2 - 2*x1 + x0
+	if denom == 0 {
+		return x2
+	}
+	return x0 - (x1-x0)*(x1-x0)/denom
+}
+
+// Aitken ускоряет метод простой итерации Δ²-процессом Эйткена: по трём
+// последним членам последовательности x_{k+1} = φ(x_k) строится
+// экстраполированное значение, а остановка производится по разности двух
+// последовательных экстраполяций. Сама последовательность не меняется.
+func Aitken(phi func(float64) float64, x0 float64, params Params) (Result, error) {
+	if err := params.validate(); err != nil {
+		return Result{Root: x0}, err
+	}
+
+	res := Result{Root: x0, PhiEvals: 1}
+	x := [3]float64{x0, phi(x0), 0}
+	prev := math.NaN()
+	for i := 0; i < params.MaxIter; i++ {
+		x[2] = phi(x[1])
+		res.PhiEvals++
+		if math.IsNaN(x[2]) || math.IsInf(x[2], 0) {
+			return res, fmt.Errorf("итерации расходятся на шаге %d", i+2)
+		}
+		acc := aitken(x[0], x[1], x[2])
+		res.Root = acc
+
+		// Итерацией считается каждая пара экстраполяций, по которой
+		// проверяется остановка, — так Iterations совпадает с len(Errors)
+		if !math.IsNaN(prev) {
+			step := acc - prev
+			res.Errors = append(res.Errors, math.Abs(step))
+			res.Iterations = len(res.Errors)
+			if math.Abs(step) <= params.stepTolerance(acc) {
+				res.Converged = true
+				return res, nil
+			}
+		}
+		prev = acc
+		x[0], x[1] = x[1], x[2]
+	}
+	return res, nil
+}
+
+// Steffensen — метод Стеффенсена: из каждого приближения x строится
+// x − (φ(x) − x)²/(φ(φ(x)) − 2φ(x) + x), что даёт квадратичную сходимость
+// ценой двух вычислений φ на итерацию.
+func Steffensen(phi func(float64) float64, x0 float64, params Params) (Result, error) {
+	if err := params.validate(); err != nil {
+		return Result{Root: x0}, err
+	}
+
+	res := Result{Root: x0}
+	x := x0
+	for i := 0; i < params.MaxIter; i++ {
+		x1 := phi(x)
+		x2 := phi(x1)
+		res.PhiEvals += 2
+		if math.IsNaN(x2) || math.IsInf(x2, 0) {
+			return res, fmt.Errorf("итерации расходятся на шаге %d", i+1)
+		}
+		xNew := aitken(x, x1, x2)
+		step := xNew - x
+		res.Errors = append(res.Errors, math.Abs(step))
+		res.Root = xNew
+		res.Iterations = i + 1
+
+		if math.Abs(step) <= params.stepTolerance(xNew) {
+			res.Converged = true
+			return res, nil
+		}
+		x = xNew
+	}
+	return res, nil
+}
@@ -0,0 +1,176 @@
+package root_finding
+
+import (
+	"math"
+
+	"github.com/KaiserRed/numeric_methods/internal/svd_decompose"
+)
+
+func Bisection(f func(float64) float64, a, b float64, params Params) (Result, error) {
+	if err := params.validate(); err != nil {
+		return Result{}, err
+	}
+	fa, fb := f(a), f(b)
+	if err := checkBracket(fa, fb); err != nil {
+		return Result{}, err
+	}
+	if fa == 0 {
+		return Result{Root: a, Converged: true}, nil
+	}
+	if fb == 0 {
+		return Result{Root: b, Converged: true}, nil
+	}
+
+	res := Result{}
+	for i := 0; i < params.MaxIter; i++ {
+		mid := a + (b-a)/2
+		fm := f(mid)
+		halfWidth := math.Abs(b-a) / 2
+		res.Errors = append(res.Errors, halfWidth)
+		res.Root = mid
+		res.Iterations = i + 1
+
+		if fm == 0 || params.done(mid, halfWidth, fm) {
+			res.Converged = true
+			return res, nil
+		}
+		if fa*fm < 0 {
+			b = mid
+		} else {
+			a, fa = mid, fm
+		}
+	}
+	return res, nil
+}
+
+// RegulaFalsi — метод ложного положения с модификацией Illinois:
+// если один конец не меняется два шага подряд, его значение функции делится пополам.
+func RegulaFalsi(f func(float64) float64, a, b float64, params Params) (Result, error) {
+	if err := params.validate(); err != nil {
+		return Result{}, err
+	}
+	fa, fb := f(a), f(b)
+	if err := checkBracket(fa, fb); err != nil {
+		return Result{}, err
+	}
+	if fa == 0 {
+		return Result{Root: a, Converged: true}, nil
+	}
+	if fb == 0 {
+		return Result{Root: b, Converged: true}, nil
+	}
+
+	res := Result{Root: a}
+	side := 0
+	prev := a
+	for i := 0; i < params.MaxIter; i++ {
+		c := (a*fb - b*fa) / (fb - fa)
+		fc := f(c)
+		step := c - prev
+		if i == 0 {
+			step = b - a
+		}
+		res.Errors = append(res.Errors, math.Abs(step))
+		res.Root = c
+		res.Iterations = i + 1
+
+		if fc == 0 || params.done(c, step, fc) {
+			res.Converged = true
+			return res, nil
+		}
+
+		if fc*fb > 0 {
+			b, fb = c, fc
+			if side == -1 {
+				fa /= 2
+			}
+			side = -1
+		} else {
+			a, fa = c, fc
+			if side == 1 {
+				fb /= 2
+			}
+			side = 1
+		}
+		prev = c
+	}
+	return res, nil
+}
+
+// Brent сочетает бисекцию, метод секущих и обратную квадратичную
+// интерполяцию; сходимость гарантирована при наличии смены знака.
+func Brent(f func(float64) float64, a, b float64, params Params) (Result, error) {
+	if err := params.validate(); err != nil {
+		return Result{}, err
+	}
+	fa, fb := f(a), f(b)
+	if err := checkBracket(fa, fb); err != nil {
+		return Result{}, err
+	}
+
+	c, fc := b, fb
+	d, e := b-a, b-a
+	res := Result{Root: b}
+
+	for i := 0; i < params.MaxIter; i++ {
+		if (fb > 0 && fc > 0) || (fb < 0 && fc < 0) {
+			c, fc = a, fa
+			d = b - a
+			e = d
+		}
+		if math.Abs(fc) < math.Abs(fb) {
+			a, b, c = b, c, b
+			fa, fb, fc = fb, fc, fb
+		}
+
+		tol := 2*svd_decompose.MachineEpsilon*math.Abs(b) + 0.5*params.stepTolerance(b)
+		xm := 0.5 * (c - b)
+		res.Errors = append(res.Errors, math.Abs(xm))
+		res.Root = b
+		res.Iterations = i + 1
+		if math.Abs(xm) <= tol || fb == 0 || math.Abs(fb) <= params.FTol {
+			res.Converged = true
+			return res, nil
+		}
+
+		if math.Abs(e) >= tol && math.Abs(fa) > math.Abs(fb) {
+			var p, q float64
+			s := fb / fa
+			if a == c {
+				// Секущая
+				p = 2 * xm * s
+				q = 1 - s
+			} else {
+				// Обратная квадратичная интерполяция
+				q = fa / fc
+				r := fb / fc
+				p = s * (2*xm*q*(q-r) - (b-a)*(r-1))
+				q = (q - 1) * (r - 1) * (s - 1)
+			}
+			if p > 0 {
+				q = -q
+			}
+			p = math.Abs(p)
+			if 2*p < math.Min(3*xm*q-math.Abs(tol*q), math.Abs(e*q)) {
+				e = d
+				d = p / q
+			} else {
+				d = xm
+				e = d
+			}
+		} else {
+			d = xm
+			e = d
+		}
+
+		a, fa = b, fb
+		if math.Abs(d) > tol {
+			b += d
+		} else {
+			b += math.Copysign(tol, xm)
+		}
+		fb = f(b)
+	}
+	res.Root = b
+	return res, nil
+}
@@ -0,0 +1,232 @@
+package root_finding
+
+import (
+	"fmt"
+	"math"
+	"math/cmplx"
+)
+
+// ComplexResult — результат поиска комплексного корня.
+type ComplexResult struct {
+	Root       complex128
+	Iterations int
+	Errors     []float64
+	Converged  bool
+}
+
+// PolynomialResult — результат одновременного поиска всех корней многочлена.
+// Errors — максимальный модуль поправки по всем корням на каждой итерации.
+type PolynomialResult struct {
+	Roots      []complex128
+	Iterations int
+	Errors     []float64
+	Converged  bool
+}
+
+func (p Params) doneComplex(z, step, fz complex128) bool {
+	return p.done(cmplx.Abs(z), cmplx.Abs(step), cmplx.Abs(fz))
+}
+
+// ComplexNewton — метод Ньютона z_{k+1} = z_k − f(z_k)/f'(z_k) для
+// аналитической функции. Чтобы попасть в невещественный корень вещественной
+// функции, начальное приближение должно иметь ненулевую мнимую часть.
+func ComplexNewton(f, df func(complex128) complex128, z0 complex128, params Params) (ComplexResult, error) {
+	if err := params.validate(); err != nil {
+		return ComplexResult{}, err
+	}
+
+	res := ComplexResult{Root: z0}
+	z := z0
+	for i := 0; i < params.MaxIter; i++ {
+		fz, dfz := f(z), df(z)
+		if fz == 0 {
+			res.Converged = true
+			return res, nil
+		}
+		if smallDivisor(cmplx.Abs(dfz), cmplx.Abs(fz)) {
+			if params.settled(res.Errors, cmplx.Abs(res.Root), cmplx.Abs(fz)) {
+				res.Converged = true
+				return res, nil
+			}
+			return res, fmt.Errorf("производная близка к нулю на итерации %d", i+1)
+		}
+
+		zNew := z - fz/dfz
+		if cmplx.IsNaN(zNew) || cmplx.IsInf(zNew) {
+			return res, fmt.Errorf("итерации расходятся на шаге %d", i+1)
+		}
+		step := zNew - z
+		res.Errors = append(res.Errors, cmplx.Abs(step))
+		res.Root = zNew
+		res.Iterations = i + 1
+
+		if params.doneComplex(zNew, step, fz) {
+			res.Converged = true
+			return res, nil
+		}
+		z = zNew
+	}
+	return res, nil
+}
+
+// Muller — метод Мюллера: через три последние точки проводится парабола,
+// следующее приближение — ближайший к z₂ её корень. Комплексная арифметика
+// позволяет сойтись к комплексному корню даже из вещественных приближений.
+func Muller(f func(complex128) complex128, z0, z1, z2 complex128, params Params) (ComplexResult, error) {
+	if err := params.validate(); err != nil {
+		return ComplexResult{}, err
+	}
+
+	res := ComplexResult{Root: z2}
+	f0, f1, f2 := f(z0), f(z1), f(z2)
+	for i := 0; i < params.MaxIter; i++ {
+		if f2 == 0 {
+			res.Converged = true
+			return res, nil
+		}
+		h1, h2 := z1-z0, z2-z1
+		if h1 == 0 || h2 == 0 || h1+h2 == 0 {
+			return res, fmt.Errorf("совпадающие точки на итерации %d", i+1)
+		}
+		d1, d2 := (f1-f0)/h1, (f2-f1)/h2
+		a := (d2 - d1) / (h2 + h1)
+		b := a*h2 + d2
+		disc := cmplx.Sqrt(b*b - 4*f2*a)
+
+		// Знаменатель с большим модулем даёт корень параболы, ближайший к z₂
+		den := b + disc
+		if cmplx.Abs(b-disc) > cmplx.Abs(den) {
+			den = b - disc
+		}
+		if den == 0 {
+			return res, fmt.Errorf("парабола вырождена на итерации %d", i+1)
+		}
+
+		step := -2 * f2 / den
+		z3 := z2 + step
+		res.Errors = append(res.Errors, cmplx.Abs(step))
+		res.Root = z3
+		res.Iterations = i + 1
+
+		f3 := f(z3)
+		if cmplx.IsNaN(f3) || cmplx.IsInf(f3) {
+			return res, fmt.Errorf("функция не определена в точке %v на итерации %d", z3, i+1)
+		}
+		if params.doneComplex(z3, step, f3) {
+			res.Converged = true
+			return res, nil
+		}
+		z0, z1, z2 = z1, z2, z3
+		f0, f1, f2 = f1, f2, f3
+	}
+	return res, nil
+}
+
+// DurandKerner — метод Вейерштрасса (Дюрана–Кернера): все корни многочлена
+// c[0]·zⁿ + … + c[n] уточняются одновременно,
+// z_i ← z_i − p(z_i) / (c[0]·∏_{j≠i}(z_i − z_j)).
+func DurandKerner(coeffs []complex128, params Params) (PolynomialResult, error) {
+	return simultaneous(coeffs, params, func(c []complex128, z []complex128, i int) complex128 {
+		p, _ := hornerComplex(c, z[i])
+		den := c[0]
+		for j := range z {
+			if j != i {
+				den *= z[i] - z[j]
+			}
+		}
+		return p / den
+	})
+}
+
+// Aberth — метод Эрлиха–Аберта: поправка Ньютона w = p/p' корректируется
+// отталкиванием от остальных приближений, Δz_i = w / (1 − w·∑_{j≠i} 1/(z_i − z_j)).
+// Сходимость к простым корням кубическая.
+func Aberth(coeffs []complex128, params Params) (PolynomialResult, error) {
+	return simultaneous(coeffs, params, func(c []complex128, z []complex128, i int) complex128 {
+		p, dp := hornerComplex(c, z[i])
+		if p == 0 {
+			return 0
+		}
+		w := p / dp
+		var sum complex128
+		for j := range z {
+			if j != i {
+				sum += 1 / (z[i] - z[j])
+			}
+		}
+		return w / (1 - w*sum)
+	})
+}
+
+// simultaneous выполняет итерации одновременного уточнения корней по схеме
+// Гаусса–Зейделя: поправка корня сразу используется для следующих.
+func simultaneous(coeffs []complex128, params Params, correction func(c, z []complex128, i int) complex128) (PolynomialResult, error) {
+	if err := params.validate(); err != nil {
+		return PolynomialResult{}, err
+	}
+	start := 0
+	for start < len(coeffs) && coeffs[start] == 0 {
+		start++
+	}
+	c := coeffs[start:]
+	if len(c) == 0 {
+		return PolynomialResult{}, fmt.Errorf("многочлен тождественно равен нулю")
+	}
+	n := len(c) - 1
+	if n == 0 {
+		return PolynomialResult{Converged: true}, nil
+	}
+
+	z := initialGuesses(c)
+	res := PolynomialResult{Roots: z}
+	for k := 0; k < params.MaxIter; k++ {
+		maxStep, converged := 0.0, true
+		for i := range z {
+			step := correction(c, z, i)
+			if cmplx.IsNaN(step) || cmplx.IsInf(step) {
+				return res, fmt.Errorf("итерации расходятся на шаге %d", k+1)
+			}
+			z[i] -= step
+			maxStep = math.Max(maxStep, cmplx.Abs(step))
+			p, _ := hornerComplex(c, z[i])
+			converged = converged && params.doneComplex(z[i], step, p)
+		}
+		res.Errors = append(res.Errors, maxStep)
+		res.Iterations = k + 1
+		if converged {
+			res.Converged = true
+			break
+		}
+	}
+	sortRoots(res.Roots)
+	return res, nil
+}
+
+// initialGuesses располагает начальные приближения на окружности радиуса
+// 1 + max|c_k/c_0| (оценка Коши), слегка повёрнутой, чтобы не попасть
+// на ось симметрии вещественного многочлена.
+func initialGuesses(c []complex128) []complex128 {
+	n := len(c) - 1
+	radius := 0.0
+	for _, ck := range c[1:] {
+		radius = math.Max(radius, cmplx.Abs(ck/c[0]))
+	}
+	radius++
+	z := make([]complex128, n)
+	for k := range z {
+		angle := 2*math.Pi*float64(k)/float64(n) + 0.4
+		z[k] = cmplx.Rect(radius, angle)
+	}
+	return z
+}
+
+// hornerComplex возвращает значение многочлена с комплексными
+// коэффициентами и его производной в точке z.
+func hornerComplex(coeffs []complex128, z complex128) (complex128, complex128) {
+	var p, dp complex128
+	for _, c := range coeffs {
+		dp = dp*z + p
+		p = p*z + c
+	}
+	return p, dp
+}
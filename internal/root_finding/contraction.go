@@ -0,0 +1,126 @@
+package root_finding
+
+import (
+	"fmt"
+	"math"
+)
+
+// Contraction — результат анализа отображения φ на отрезке [a, b].
+type Contraction struct {
+	Q          float64 // оценка q = max|φ'(x)|
+	WorstPoint float64 // точка, в которой достигается максимум
+	ImageLo    float64 // min φ(x) по точкам выборки
+	ImageHi    float64 // max φ(x) по точкам выборки
+	// SelfMapping — φ([a, b]) ⊂ [a, b]
+	SelfMapping bool
+	Samples     int
+}
+
+// ContractionMargin — запас, с которым оценка q по выборке должна быть
+// меньше единицы: максимум по точкам лишь приближает sup|φ'|, а при q → 1
+// множитель q/(1 − q) в апостериорной оценке неограниченно растёт.
+const ContractionMargin = 1e-3
+
+// ContractionProved сообщает, что q <= 1 − ContractionMargin.
+func ContractionProved(q float64) bool {
+	return q <= 1-ContractionMargin
+}
+
+// QNearUnity сообщает, что q < 1 лишь по выборке, но слишком близко к 1,
+// чтобы считать сжатие доказанным.
+func QNearUnity(q float64) bool {
+	return q < 1 && !ContractionProved(q)
+}
+
+// IsContraction сообщает, выполнены ли условия теоремы о сжимающем
+// отображении с запасом ContractionMargin.
+func (c Contraction) IsContraction() bool {
+	return ContractionProved(c.Q) && c.SelfMapping
+}
+
+func (c Contraction) NearUnity() bool {
+	return QNearUnity(c.Q)
+}
+
+// AnalyzeContraction оценивает q = max|φ'(x)| и образ φ([a, b]) по
+// равномерной выборке из samples точек. Если dphi не задана, производная
+// вычисляется центральными разностями.
+func AnalyzeContraction(phi, dphi func(float64) float64, a, b float64, samples int) (Contraction, error) {
+	if a >= b {
+		return Contraction{}, fmt.Errorf("левая граница должна быть меньше правой")
+	}
+	if samples < 2 {
+		return Contraction{}, fmt.Errorf("требуется хотя бы 2 точки выборки")
+	}
+	if dphi == nil {
+		dphi = func(x float64) float64 {
+			h := 6.055454452393343e-06 * math.Max(1, math.Abs(x)) // ∛ε
+			return (phi(x+h) - phi(x-h)) / (2 * h)
+		}
+	}
+
+	c := Contraction{ImageLo: math.Inf(1), ImageHi: math.Inf(-1), Samples: samples}
+	for i := 0; i < samples; i++ {
+		x := a + (b-a)*float64(i)/float64(samples-1)
+		d := math.Abs(dphi(x))
+		if math.IsNaN(d) || math.IsInf(d, 0) {
+			return c, fmt.Errorf("производная φ не определена в точке x = %g", x)
+		}
+		if d > c.Q || i == 0 {
+			c.Q, c.WorstPoint = d, x
+		}
+		y := phi(x)
+		if math.IsNaN(y) {
+			return c, fmt.Errorf("φ не определена в точке x = %g", x)
+		}
+		c.ImageLo = math.Min(c.ImageLo, y)
+		c.ImageHi = math.Max(c.ImageHi, y)
+	}
+	c.SelfMapping = c.ImageLo >= a && c.ImageHi <= b
+	return c, nil
+}
+
+// Relaxation строит итерационную функцию φ(x) = x − λ·f(x) с λ = 1/f'(x0)
+// и её производную φ'(x) = 1 − λ·f'(x).
+func Relaxation(f, df func(float64) float64, x0 float64) (phi, dphi func(float64) float64, lambda float64, err error) {
+	d := df(x0)
+	if math.Abs(d) < derivativeThreshold || math.IsNaN(d) {
+		return nil, nil, 0, fmt.Errorf("производная f в точке x0 = %g близка к нулю", x0)
+	}
+	lambda = 1 / d
+	phi = func(x float64) float64 { return x - lambda*f(x) }
+	dphi = func(x float64) float64 { return 1 - lambda*df(x) }
+	return phi, dphi, lambda, nil
+}
+
+// ContractiveFixedPoint — метод простой итерации с апостериорной оценкой
+// |x_k − x*| <= q/(1 − q)·|x_k − x_{k−1}|. В Errors записываются эти оценки.
+func ContractiveFixedPoint(phi func(float64) float64, x0, q float64, params Params) (Result, error) {
+	if err := params.validate(); err != nil {
+		return Result{Root: x0}, err
+	}
+	if q < 0 || q >= 1 {
+		return Result{Root: x0}, fmt.Errorf("коэффициент сжатия q = %g не лежит в [0, 1)", q)
+	}
+
+	res := Result{Root: x0}
+	x := x0
+	for i := 0; i < params.MaxIter; i++ {
+		xNew := phi(x)
+		res.PhiEvals++
+		if math.IsNaN(xNew) || math.IsInf(xNew, 0) {
+			return res, fmt.Errorf("итерации расходятся на шаге %d", i+1)
+		}
+		bound := q / (1 - q) * math.Abs(xNew-x)
+		res.Errors = append(res.Errors, bound)
+		res.Root = xNew
+		res.Iterations = i + 1
+
+		if bound <= params.stepTolerance(xNew) {
+			res.Converged = true
+			return res, nil
+		}
+		x = xNew
+	}
+	return res, nil
+}
@@ -0,0 +1,158 @@
+package root_finding
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+)
+
+// Ошибки меньше этого порога считаются искажёнными округлением и не
+// используются при оценке порядка сходимости
+const orderErrorFloor = 1e-14
+
+// Число последних троек ошибок, по которым оценивается порядок сходимости
+const orderTriples = 3
+
+// iterate выполняет итерации x_{k+1} = x_k − step(x_k); step возвращает
+// поправку и значение f(x_k) для критерия остановки.
+func iterate(step func(x float64) (float64, float64, error), x0 float64, params Params) (Result, error) {
+	if err := params.validate(); err != nil {
+		return Result{Root: x0}, err
+	}
+
+	res := Result{Root: x0}
+	x := x0
+	for i := 0; i < params.MaxIter; i++ {
+		dx, fx, err := step(x)
+		if err != nil {
+			if errors.Is(err, errDegenerateStep) && params.settled(res.Errors, res.Root, fx) {
+				res.Converged = true
+				return res, nil
+			}
+			return res, fmt.Errorf("итерация %d: %w", i+1, err)
+		}
+		xNew := x - dx
+		if math.IsNaN(xNew) || math.IsInf(xNew, 0) {
+			return res, fmt.Errorf("итерации расходятся на шаге %d", i+1)
+		}
+		res.Errors = append(res.Errors, math.Abs(dx))
+		res.Root = xNew
+		res.Iterations = i + 1
+
+		if fx == 0 || params.done(xNew, dx, fx) {
+			res.Converged = true
+			return res, nil
+		}
+		x = xNew
+	}
+	return res, nil
+}
+
+// ModifiedNewton — метод Ньютона для корня кратности m: x − m·f/f'.
+// Восстанавливает квадратичную сходимость, если m известна.
+func ModifiedNewton(f, df func(float64) float64, m int, x0 float64, params Params) (Result, error) {
+	if m < 1 {
+		return Result{Root: x0}, fmt.Errorf("кратность должна быть положительной")
+	}
+	return iterate(func(x float64) (float64, float64, error) {
+		fx, dfx := f(x), df(x)
+		if fx == 0 {
+			return 0, 0, nil
+		}
+		if smallDivisor(dfx, fx) {
+			return 0, fx, fmt.Errorf("производная близка к нулю: %w", errDegenerateStep)
+		}
+		return float64(m) * fx / dfx, fx, nil
+	}, x0, params)
+}
+
+// NewtonQuotient — метод Ньютона для u = f/f', у которой все корни f
+// простые: x − f·f'/(f'² − f·f”). Кратность знать не нужно.
+func NewtonQuotient(f, df, d2f func(float64) float64, x0 float64, params Params) (Result, error) {
+	return iterate(func(x float64) (float64, float64, error) {
+		fx, dfx, d2fx := f(x), df(x), d2f(x)
+		if fx == 0 {
+			return 0, 0, nil
+		}
+		denom := dfx*dfx - fx*d2fx
+		if smallDivisor(denom, fx*dfx) {
+			return 0, fx, fmt.Errorf("знаменатель f'² − f·f'' близок к нулю: %w", errDegenerateStep)
+		}
+		return fx * dfx / denom, fx, nil
+	}, x0, params)
+}
+
+// Halley — метод Галлея с кубической сходимостью к простому корню:
+// x − 2·f·f'/(2·f'² − f·f”).
+func Halley(f, df, d2f func(float64) float64, x0 float64, params Params) (Result, error) {
+	return iterate(func(x float64) (float64, float64, error) {
+		fx, dfx, d2fx := f(x), df(x), d2f(x)
+		if fx == 0 {
+			return 0, 0, nil
+		}
+		denom := 2*dfx*dfx - fx*d2fx
+		if smallDivisor(denom, 2*fx*dfx) {
+			return 0, fx, fmt.Errorf("знаменатель 2·f'² − f·f'' близок к нулю: %w", errDegenerateStep)
+		}
+		return 2 * fx * dfx / denom, fx, nil
+	}, x0, params)
+}
+
+// EstimateMultiplicity оценивает кратность корня вблизи точки x:
+// для u = f/f' производная u' = 1 − f·f”/f'² стремится к 1/m.
+func EstimateMultiplicity(f, df, d2f func(float64) float64, x float64) float64 {
+	fx, dfx, d2fx := f(x), df(x), d2f(x)
+	denom := dfx*dfx - fx*d2fx
+	if denom == 0 {
+		return math.NaN()
+	}
+	return dfx * dfx / denom
+}
+
+// MultiplicityFromErrors оценивает кратность по истории шагов метода
+// Ньютона: при кратном корне шаги убывают линейно с отношением 1 − 1/m.
+func MultiplicityFromErrors(errors []float64) float64 {
+	e := usableErrors(errors)
+	if len(e) < 2 {
+		return math.NaN()
+	}
+	r := e[len(e)-1] / e[len(e)-2]
+	if r >= 1 {
+		return math.NaN()
+	}
+	return 1 / (1 - r)
+}
+
+// ConvergenceOrder оценивает порядок сходимости по истории ошибок:
+// p ≈ ln(e_{k+1}/e_k) / ln(e_k/e_{k−1}) для последних троек ошибок, не
+// искажённых округлением. Берётся медиана не более чем orderTriples оценок,
+// чтобы последний шаг, упавший до уровня шума, не исказил результат.
+// Если данных недостаточно, возвращается NaN.
+func ConvergenceOrder(errors []float64) float64 {
+	e := usableErrors(errors)
+	var orders []float64
+	for k := len(e) - 2; k >= 1 && len(orders) < orderTriples; k-- {
+		den := math.Log(e[k] / e[k-1])
+		if den == 0 {
+			continue
+		}
+		orders = append(orders, math.Log(e[k+1]/e[k])/den)
+	}
+	if len(orders) == 0 {
+		return math.NaN()
+	}
+	sort.Float64s(orders)
+	return orders[len(orders)/2]
+}
+
+// usableErrors возвращает начальный отрезок истории до первой ошибки ниже
+// orderErrorFloor.
+func usableErrors(errors []float64) []float64 {
+	for i, e := range errors {
+		if e < orderErrorFloor {
+			return errors[:i]
+		}
+	}
+	return errors
+}
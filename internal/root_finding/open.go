@@ -0,0 +1,198 @@
+package root_finding
+
+import (
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/KaiserRed/numeric_methods/internal/autodiff"
+)
+
+const derivativeThreshold = 1e-12
+
+// errDegenerateStep — поправка num/den не определена: знаменатель мал
+// относительно числителя.
+var errDegenerateStep = errors.New("шаг не определён")
+
+// smallDivisor сообщает, что поправка num/den превысила бы
+// 1/derivativeThreshold. Порог относительный: у кратного корня f и f'
+// стремятся к нулю одновременно, и их отношение остаётся конечным.
+func smallDivisor(den, num float64) bool {
+	return math.Abs(den) <= derivativeThreshold*math.Abs(num)
+}
+
+// settled сообщает, что вырожденный шаг возник уже у корня: |f| или
+// последний шаг из истории errors не превосходят допуска в точке x.
+func (p Params) settled(errors []float64, x, fx float64) bool {
+	if math.Abs(fx) <= p.FTol {
+		return true
+	}
+	n := len(errors)
+	return n > 0 && errors[n-1] <= p.stepTolerance(x)
+}
+
+// FixedPoint — метод простой итерации x_{k+1} = φ(x_k).
+func FixedPoint(phi func(float64) float64, x0 float64, params Params) (Result, error) {
+	if err := params.validate(); err != nil {
+		return Result{}, err
+	}
+
+	res := Result{Root: x0}
+	x := x0
+	for i := 0; i < params.MaxIter; i++ {
+		xNew := phi(x)
+		res.PhiEvals++
+		if math.IsNaN(xNew) || math.IsInf(xNew, 0) {
+			return res, fmt.Errorf("итерации расходятся на шаге %d", i+1)
+		}
+		step := xNew - x
+		res.Errors = append(res.Errors, math.Abs(step))
+		res.Root = xNew
+		res.Iterations = i + 1
+
+		if math.Abs(step) <= params.stepTolerance(xNew) {
+			res.Converged = true
+			return res, nil
+		}
+		x = xNew
+	}
+	return res, nil
+}
+
+func Secant(f func(float64) float64, x0, x1 float64, params Params) (Result, error) {
+	if err := params.validate(); err != nil {
+		return Result{}, err
+	}
+
+	res := Result{Root: x1}
+	f0, f1 := f(x0), f(x1)
+	for i := 0; i < params.MaxIter; i++ {
+		if f1 == f0 {
+			return res, fmt.Errorf("секущая горизонтальна на итерации %d", i+1)
+		}
+		x2 := x1 - f1*(x1-x0)/(f1-f0)
+		f2 := f(x2)
+		if math.IsNaN(x2) || math.IsInf(x2, 0) || math.IsNaN(f2) || math.IsInf(f2, 0) {
+			return res, fmt.Errorf("итерации расходятся на шаге %d: x = %g, f(x) = %g", i+1, x2, f2)
+		}
+		step := x2 - x1
+		res.Errors = append(res.Errors, math.Abs(step))
+		res.Root = x2
+		res.Iterations = i + 1
+
+		if f2 == 0 || params.done(x2, step, f2) {
+			res.Converged = true
+			return res, nil
+		}
+		x0, f0 = x1, f1
+		x1, f1 = x2, f2
+	}
+	return res, nil
+}
+
+func Newton(f, df func(float64) float64, x0 float64, params Params) (Result, error) {
+	return newton(func(x float64) (float64, float64) { return f(x), df(x) }, x0, params)
+}
+
+// NewtonAD — метод Ньютона для функции над дуальными числами:
+// производная получается автоматическим дифференцированием.
+func NewtonAD(f func(autodiff.Dual) autodiff.Dual, x0 float64, params Params) (Result, error) {
+	return newton(func(x float64) (float64, float64) { return autodiff.Derivative(f, x) }, x0, params)
+}
+
+func newton(eval func(float64) (float64, float64), x0 float64, params Params) (Result, error) {
+	if err := params.validate(); err != nil {
+		return Result{}, err
+	}
+
+	res := Result{Root: x0}
+	x := x0
+	for i := 0; i < params.MaxIter; i++ {
+		fx, dfx := eval(x)
+		if fx == 0 {
+			res.Converged = true
+			return res, nil
+		}
+		if smallDivisor(dfx, fx) {
+			if params.settled(res.Errors, res.Root, fx) {
+				res.Converged = true
+				return res, nil
+			}
+			return res, fmt.Errorf("производная близка к нулю на итерации %d", i+1)
+		}
+
+		xNew := x - fx/dfx
+		step := xNew - x
+		res.Errors = append(res.Errors, math.Abs(step))
+		res.Root = xNew
+		res.Iterations = i + 1
+
+		if params.done(xNew, step, fx) {
+			res.Converged = true
+			return res, nil
+		}
+		x = xNew
+	}
+	return res, nil
+}
+
+// SafeNewton — метод Ньютона с защитой: корень удерживается в отрезке
+// со сменой знака, и если шаг Ньютона выводит за отрезок или сокращает его
+// недостаточно быстро, выполняется шаг бисекции.
+func SafeNewton(f, df func(float64) float64, a, b float64, params Params) (Result, error) {
+	if err := params.validate(); err != nil {
+		return Result{}, err
+	}
+	fa, fb := f(a), f(b)
+	if err := checkBracket(fa, fb); err != nil {
+		return Result{}, err
+	}
+	if fa == 0 {
+		return Result{Root: a, Converged: true}, nil
+	}
+	if fb == 0 {
+		return Result{Root: b, Converged: true}, nil
+	}
+	if fa > 0 {
+		a, b = b, a
+	}
+
+	x := (a + b) / 2
+	// dx — последний шаг, dxOld — предыдущий: шаг Ньютона принимается,
+	// только если он вдвое меньше шага двумя итерациями раньше
+	dx := math.Abs(b - a)
+	dxOld := dx
+	res := Result{Root: x}
+	for i := 0; i < params.MaxIter; i++ {
+		fx := f(x)
+		if fx == 0 {
+			res.Converged = true
+			return res, nil
+		}
+		if fx < 0 {
+			a = x
+		} else {
+			b = x
+		}
+
+		dfx := df(x)
+		xNew := x - fx/dfx
+		outside := (xNew-a)*(xNew-b) > 0
+		if math.Abs(dfx) < derivativeThreshold || outside || math.Abs(2*fx) > math.Abs(dxOld*dfx) {
+			xNew = (a + b) / 2
+		}
+
+		step := xNew - x
+		dxOld, dx = dx, step
+		res.Errors = append(res.Errors, math.Abs(step))
+		res.Root = xNew
+		res.Iterations = i + 1
+
+		if params.done(xNew, step, fx) {
+			res.Converged = true
+			return res, nil
+		}
+		x = xNew
+	}
+	return res, nil
+}
@@ -0,0 +1,190 @@
+package root_finding
+
+import (
+	"fmt"
+	"math"
+	"math/cmplx"
+	"sort"
+
+	"github.com/KaiserRed/numeric_methods/internal/eigen"
+	"github.com/KaiserRed/numeric_methods/internal/svd_decompose"
+)
+
+// Число шагов уточнения корней многочлена методом Ньютона
+const polishIterations = 50
+
+// Относительный радиус, в котором собственные значения проверяются на
+// принадлежность одному кратному корню: облако вокруг корня кратности m
+// имеет радиус порядка ε^{1/m}.
+const clusterRadius = 1e-2
+
+// PolynomialRoots находит все комплексные корни многочлена
+// c[0]·xⁿ + c[1]·xⁿ⁻¹ + … + c[n] как собственные значения сопровождающей
+// матрицы (QR-алгоритм со сдвигами) и уточняет каждый корень методом
+// Ньютона. Близкие собственные значения, являющиеся одним кратным корнем,
+// объединяются. Если относительная невязка |p(z)|/Σ|c_k|·|z|ⁿ⁻ᵏ
+// уточнённого корня больше epsilon, возвращается ошибка.
+func PolynomialRoots(coeffs []float64, epsilon float64) ([]complex128, error) {
+	start := 0
+	for start < len(coeffs) && coeffs[start] == 0 {
+		start++
+	}
+	c := coeffs[start:]
+	if len(c) == 0 {
+		return nil, fmt.Errorf("многочлен тождественно равен нулю")
+	}
+
+	// Нулевые корни соответствуют нулевым младшим коэффициентам
+	var roots []complex128
+	for len(c) > 1 && c[len(c)-1] == 0 {
+		roots = append(roots, 0)
+		c = c[:len(c)-1]
+	}
+
+	n := len(c) - 1
+	if n > 0 {
+		// Сопровождающая матрица — верхняя хессенбергова
+		C := make([][]float64, n)
+		for i := range C {
+			C[i] = make([]float64, n)
+			if i > 0 {
+				C[i][i-1] = 1
+			}
+		}
+		for j := 0; j < n; j++ {
+			C[0][j] = -c[j+1] / c[0]
+		}
+
+		eigenvalues, _, err := eigen.HessenbergQR(C)
+		if err != nil {
+			return nil, err
+		}
+		for _, z := range mergeClusters(c, eigenvalues) {
+			if be := backwardError(c, z); be > epsilon {
+				return nil, fmt.Errorf("корень %v не уточнён: относительная невязка %.1e", z, be)
+			}
+			roots = append(roots, z)
+		}
+	}
+
+	sortRoots(roots)
+	return roots, nil
+}
+
+// mergeClusters уточняет собственные значения как корни многочлена c.
+// Группа из m близких значений заменяется одним корнем кратности m:
+// их среднее уточняется методом Ньютона для p⁽ᵐ⁻¹⁾, у которой кратный
+// корень простой. Группа принимается, только если невязка полученного
+// корня не хуже, чем у отдельных значений; иначе это разные корни.
+func mergeClusters(c []float64, eigenvalues []complex128) []complex128 {
+	n := len(eigenvalues)
+	used := make([]bool, n)
+	var roots []complex128
+	for i, z := range eigenvalues {
+		if used[i] {
+			continue
+		}
+		members := []int{i}
+		for j := i + 1; j < n; j++ {
+			if !used[j] && cmplx.Abs(eigenvalues[j]-z) <= clusterRadius*math.Max(1, cmplx.Abs(z)) {
+				members = append(members, j)
+			}
+		}
+
+		polished := make([]complex128, len(members))
+		worst := 0.0
+		var mean complex128
+		for k, j := range members {
+			polished[k] = polish(c, eigenvalues[j])
+			worst = math.Max(worst, backwardError(c, polished[k]))
+			mean += eigenvalues[j]
+		}
+		m := len(members)
+		if m > 1 {
+			mean /= complex(float64(m), 0)
+			d := c
+			for k := 1; k < m; k++ {
+				d = derivative(d)
+			}
+			root := polish(d, mean)
+			if backwardError(c, root) <= math.Max(worst, float64(n)*svd_decompose.MachineEpsilon) {
+				for k := range polished {
+					polished[k] = root
+				}
+			}
+		}
+		for k, j := range members {
+			used[j] = true
+			roots = append(roots, polished[k])
+		}
+	}
+	return roots
+}
+
+// derivative возвращает коэффициенты производной многочлена.
+func derivative(c []float64) []float64 {
+	n := len(c) - 1
+	d := make([]float64, n)
+	for k := 0; k < n; k++ {
+		d[k] = c[k] * float64(n-k)
+	}
+	return d
+}
+
+// backwardError — относительная невязка |p(z)|/Σ|c_k|·|z|ⁿ⁻ᵏ: на сколько
+// нужно возмутить коэффициенты, чтобы z стал точным корнем.
+func backwardError(c []float64, z complex128) float64 {
+	p, _ := Horner(c, z)
+	scale, az := 0.0, cmplx.Abs(z)
+	for _, ck := range c {
+		scale = scale*az + math.Abs(ck)
+	}
+	if scale == 0 {
+		return 0
+	}
+	return cmplx.Abs(p) / scale
+}
+
+// sortRoots упорядочивает корни по действительной, затем по мнимой части.
+func sortRoots(roots []complex128) {
+	sort.Slice(roots, func(i, j int) bool {
+		if real(roots[i]) != real(roots[j]) {
+			return real(roots[i]) < real(roots[j])
+		}
+		return imag(roots[i]) < imag(roots[j])
+	})
+}
+
+// Horner возвращает значение многочлена и его производной в точке z.
+func Horner(coeffs []float64, z complex128) (complex128, complex128) {
+	var p, dp complex128
+	for _, c := range coeffs {
+		dp = dp*z + p
+		p = p*z + complex(c, 0)
+	}
+	return p, dp
+}
+
+// polish уточняет корень методом Ньютона; шаг, увеличивающий |p|, отбрасывается.
+func polish(coeffs []float64, z complex128) complex128 {
+	p, dp := Horner(coeffs, z)
+	for i := 0; i < polishIterations && dp != 0; i++ {
+		next := z - p/dp
+		pn, dpn := Horner(coeffs, next)
+		if cmplx.Abs(pn) > cmplx.Abs(p) || cmplx.IsNaN(next) {
+			break
+		}
+		step := cmplx.Abs(next - z)
+		z, p, dp = next, pn, dpn
+		if step <= 1e-15*math.Max(1, cmplx.Abs(z)) {
+			break
+		}
+	}
+	if math.Abs(imag(z)) <= 1e-14*math.Max(1, cmplx.Abs(z)) {
+		z = complex(real(z), 0)
+	}
+	if math.Abs(real(z)) <= 1e-14*math.Max(1, cmplx.Abs(z)) {
+		z = complex(0, imag(z))
+	}
+	return z
+}
@@ -0,0 +1,57 @@
+package root_finding
+
+import (
+	"fmt"
+	"math"
+)
+
+// Params — общие настройки итерационных методов. Итерации останавливаются,
+// когда шаг не превосходит AbsTol + RelTol·|x| или |f(x)| <= FTol.
+type Params struct {
+	AbsTol  float64
+	RelTol  float64
+	FTol    float64
+	MaxIter int
+}
+
+// Result — результат работы метода: корень, число итераций,
+// оценки погрешности по итерациям и признак сходимости. PhiEvals — число
+// вычислений φ; заполняется методами простой итерации и их ускорениями.
+type Result struct {
+	Root       float64
+	Iterations int
+	Errors     []float64
+	Converged  bool
+	PhiEvals   int
+}
+
+func (p Params) validate() error {
+	if p.AbsTol < 0 || p.RelTol < 0 || p.FTol < 0 {
+		return fmt.Errorf("допуски должны быть неотрицательными")
+	}
+	if p.AbsTol == 0 && p.RelTol == 0 && p.FTol == 0 {
+		return fmt.Errorf("должен быть задан хотя бы один допуск")
+	}
+	if p.MaxIter <= 0 {
+		return fmt.Errorf("максимальное число итераций должно быть положительным")
+	}
+	return nil
+}
+
+func (p Params) stepTolerance(x float64) float64 {
+	return p.AbsTol + p.RelTol*math.Abs(x)
+}
+
+func (p Params) done(x, step, fx float64) bool {
+	return math.Abs(step) <= p.stepTolerance(x) || math.Abs(fx) <= p.FTol
+}
+
+func checkBracket(fa, fb float64) error {
+	if math.IsNaN(fa) || math.IsNaN(fb) {
+		return fmt.Errorf("функция не определена на концах интервала")
+	}
+	if fa*fb > 0 {
+		return fmt.Errorf("на концах интервала функция имеет одинаковые знаки")
+	}
+	return nil
+}
@@ -0,0 +1,145 @@
+package root_finding
+
+import (
+	"fmt"
+	"math"
+	"sort"
+)
+
+// Максимальная глубина адаптивного дробления отрезка при поиске корней
+const maxScanDepth = 12
+
+// Root — найденный корень; Even означает корень чётной кратности,
+// у которого функция не меняет знак.
+type Root struct {
+	X          float64
+	F          float64
+	Even       bool
+	Iterations int
+}
+
+type sample struct {
+	x, f float64
+}
+
+// FindRoots находит все корни f на [a, b]. Отрезок делится на n частей,
+// которые дополнительно дробятся там, где f заметно отклоняется от хорды;
+// каждая смена знака уточняется методом Брента. Локальные минимумы |f|
+// без смены знака исследуются методом золотого сечения: если минимум |f|
+// близок к нулю, это корень чётной кратности, а если f меняет в нём
+// знак — пара близких корней.
+func FindRoots(f func(float64) float64, a, b float64, n int, params Params) ([]Root, error) {
+	if err := params.validate(); err != nil {
+		return nil, err
+	}
+	if a >= b {
+		return nil, fmt.Errorf("левая граница должна быть меньше правой")
+	}
+	if n < 1 {
+		return nil, fmt.Errorf("число подынтервалов должно быть положительным")
+	}
+
+	samples := []sample{{a, f(a)}}
+	for i := 1; i <= n; i++ {
+		l := samples[len(samples)-1]
+		x := a + (b-a)*float64(i)/float64(n)
+		samples = scan(f, samples, l, sample{x, f(x)}, 0)
+	}
+
+	scale := 0.0
+	for _, s := range samples {
+		if !math.IsNaN(s.f) && !math.IsInf(s.f, 0) {
+			scale = math.Max(scale, math.Abs(s.f))
+		}
+	}
+	zeroTol := math.Max(params.FTol, 1e-10*math.Max(1, scale))
+
+	var roots []Root
+	add := func(r Root) {
+		for _, old := range roots {
+			if math.Abs(old.X-r.X) <= 10*params.stepTolerance(r.X) {
+				return
+			}
+		}
+		roots = append(roots, r)
+	}
+	bracket := func(l, r sample) {
+		res, err := Brent(f, l.x, r.x, params)
+		if err != nil {
+			return
+		}
+		fx := f(res.Root)
+		// Смена знака на полюсе (например, у tan x) даёт большое |f|
+		if math.Abs(fx) > math.Max(math.Abs(l.f), math.Abs(r.f)) {
+			return
+		}
+		add(Root{X: res.Root, F: fx, Iterations: res.Iterations})
+	}
+
+	for i, s := range samples {
+		if s.f == 0 {
+			even := i > 0 && i+1 < len(samples) && samples[i-1].f*samples[i+1].f > 0
+			add(Root{X: s.x, Even: even})
+			continue
+		}
+		if i == 0 {
+			continue
+		}
+		prev := samples[i-1]
+		if prev.f*s.f < 0 {
+			bracket(prev, s)
+			continue
+		}
+		if i+1 < len(samples) {
+			next := samples[i+1]
+			if math.Abs(s.f) < math.Abs(prev.f) && math.Abs(s.f) <= math.Abs(next.f) && s.f*next.f > 0 {
+				m, iters := goldenMinimum(f, prev.x, next.x, params)
+				fm := f(m)
+				switch {
+				case math.Abs(fm) <= zeroTol:
+					add(Root{X: m, F: fm, Even: true, Iterations: iters})
+				case fm*s.f < 0:
+					bracket(prev, sample{m, fm})
+					bracket(sample{m, fm}, next)
+				}
+			}
+		}
+	}
+
+	sort.Slice(roots, func(i, j int) bool { return roots[i].X < roots[j].X })
+	return roots, nil
+}
+
+// scan добавляет к samples точки из (l, r], дробя отрезок, пока значение
+// в середине далеко от хорды по сравнению с самими значениями функции.
+func scan(f func(float64) float64, samples []sample, l, r sample, depth int) []sample {
+	mx := (l.x + r.x) / 2
+	m := sample{mx, f(mx)}
+	deviation := math.Abs(m.f - (l.f+r.f)/2)
+	if depth < maxScanDepth && l.f*r.f > 0 && deviation > 0.5*math.Min(math.Abs(l.f), math.Abs(r.f)) {
+		samples = scan(f, samples, l, m, depth+1)
+		return scan(f, samples, m, r, depth+1)
+	}
+	return append(samples, m, r)
+}
+
+// goldenMinimum ищет минимум |f| на [a, b] методом золотого сечения.
+func goldenMinimum(f func(float64) float64, a, b float64, params Params) (float64, int) {
+	const invPhi = 0.6180339887498949
+	c := b - invPhi*(b-a)
+	d := a + invPhi*(b-a)
+	fc, fd := math.Abs(f(c)), math.Abs(f(d))
+	i := 0
+	for ; i < params.MaxIter && b-a > 2*params.stepTolerance((a+b)/2); i++ {
+		if fc < fd {
+			b, d, fd = d, c, fc
+			c = b - invPhi*(b-a)
+			fc = math.Abs(f(c))
+		} else {
+			a, c, fc = c, d, fd
+			d = a + invPhi*(b-a)
+			fd = math.Abs(f(d))
+		}
+	}
+	return (a + b) / 2, i
+}
@@ -0,0 +1,84 @@
+package spectrum
+
+import (
+	"math"
+	"math/cmplx"
+)
+
+// Disc — круг Гершгорина на комплексной плоскости с вещественным центром.
+type Disc struct {
+	Center float64
+	Radius float64
+}
+
+func (d Disc) Contains(z complex128, tol float64) bool {
+	return cmplx.Abs(z-complex(d.Center, 0)) <= d.Radius+tol
+}
+
+// GershgorinRows строит круги по строкам: |z - a_ii| <= Σ_{j≠i} |a_ij|.
+func GershgorinRows(A [][]float64) []Disc {
+	n := len(A)
+	discs := make([]Disc, n)
+	for i := 0; i < n; i++ {
+		radius := 0.0
+		for j := 0; j < n; j++ {
+			if j != i {
+				radius += math.Abs(A[i][j])
+			}
+		}
+		discs[i] = Disc{Center: A[i][i], Radius: radius}
+	}
+	return discs
+}
+
+// GershgorinColumns строит круги по столбцам: |z - a_jj| <= Σ_{i≠j} |a_ij|.
+func GershgorinColumns(A [][]float64) []Disc {
+	n := len(A)
+	discs := make([]Disc, n)
+	for j := 0; j < n; j++ {
+		radius := 0.0
+		for i := 0; i < n; i++ {
+			if i != j {
+				radius += math.Abs(A[i][j])
+			}
+		}
+		discs[j] = Disc{Center: A[j][j], Radius: radius}
+	}
+	return discs
+}
+
+func inUnion(discs []Disc, z complex128, tol float64) bool {
+	for _, d := range discs {
+		if d.Contains(z, tol) {
+			return true
+		}
+	}
+	return false
+}
+
+// CheckEigenvalues проверяет, что каждое собственное значение лежит
+// в пересечении объединений строчных и столбцовых кругов.
+func CheckEigenvalues(A [][]float64, eigenvalues []complex128, tol float64) []bool {
+	rows := GershgorinRows(A)
+	cols := GershgorinColumns(A)
+	ok := make([]bool, len(eigenvalues))
+	for i, z := range eigenvalues {
+		ok[i] = inUnion(rows, z, tol) && inUnion(cols, z, tol)
+	}
+	return ok
+}
+
+// RealBounds возвращает отрезок вещественной оси, содержащий вещественные
+// части всех собственных значений (по объединению строчных кругов).
+func RealBounds(A [][]float64) (float64, float64) {
+	return bounds(GershgorinRows(A))
+}
+
+func bounds(discs []Disc) (float64, float64) {
+	lo, hi := math.Inf(1), math.Inf(-1)
+	for _, d := range discs {
+		lo = math.Min(lo, d.Center-d.Radius)
+		hi = math.Max(hi, d.Center+d.Radius)
+	}
+	return lo, hi
+}
@@ -0,0 +1,170 @@
+package spectrum
+
+import (
+	"fmt"
+	"math"
+)
+
+// Interval — отрезок [Lo, Hi], гарантированно содержащий одно собственное значение.
+type Interval struct {
+	Lo float64
+	Hi float64
+}
+
+func (iv Interval) Contains(x, tol float64) bool {
+	return x >= iv.Lo-tol && x <= iv.Hi+tol
+}
+
+// Tridiagonalize приводит симметричную матрицу к трёхдиагональному виду
+// отражениями Хаусхолдера. Возвращает диагональ d и поддиагональ e.
+func Tridiagonalize(A [][]float64) ([]float64, []float64, error) {
+	n := len(A)
+	if n == 0 {
+		return nil, nil, fmt.Errorf("матрица пуста")
+	}
+	T := make([][]float64, n)
+	for i := range A {
+		if len(A[i]) != n {
+			return nil, nil, fmt.Errorf("матрица должна быть квадратной")
+		}
+		T[i] = make([]float64, n)
+		copy(T[i], A[i])
+	}
+
+	for k := 0; k < n-2; k++ {
+		alpha := 0.0
+		for i := k + 1; i < n; i++ {
+			alpha += T[i][k] * T[i][k]
+		}
+		alpha = math.Sqrt(alpha)
+		if alpha == 0 {
+			continue
+		}
+		if T[k+1][k] > 0 {
+			alpha = -alpha
+		}
+
+		v := make([]float64, n)
+		v[k+1] = T[k+1][k] - alpha
+		for i := k + 2; i < n; i++ {
+			v[i] = T[i][k]
+		}
+		vnorm := 0.0
+		for i := k + 1; i < n; i++ {
+			vnorm += v[i] * v[i]
+		}
+		if vnorm == 0 {
+			continue
+		}
+
+		// T = H·T·H, H = I - 2vvᵀ/(vᵀv)
+		p := make([]float64, n)
+		for i := 0; i < n; i++ {
+			for j := k + 1; j < n; j++ {
+				p[i] += T[i][j] * v[j]
+			}
+			p[i] *= 2 / vnorm
+		}
+		kappa := 0.0
+		for i := k + 1; i < n; i++ {
+			kappa += v[i] * p[i]
+		}
+		kappa /= vnorm
+		for i := 0; i < n; i++ {
+			p[i] -= kappa * v[i]
+		}
+		for i := 0; i < n; i++ {
+			for j := 0; j < n; j++ {
+				T[i][j] -= v[i]*p[j] + p[i]*v[j]
+			}
+		}
+	}
+
+	d := make([]float64, n)
+	e := make([]float64, n-1)
+	for i := 0; i < n; i++ {
+		d[i] = T[i][i]
+		if i < n-1 {
+			e[i] = T[i+1][i]
+		}
+	}
+	return d, e, nil
+}
+
+// SturmCount возвращает число собственных значений трёхдиагональной матрицы,
+// меньших x (число смен знака последовательности Штурма).
+func SturmCount(d, e []float64, x float64) int {
+	count := 0
+	q := 1.0
+	for i := range d {
+		if i == 0 {
+			q = d[0] - x
+		} else {
+			q = d[i] - x - e[i-1]*e[i-1]/q
+		}
+		if q == 0 {
+			q = 1e-300
+		}
+		if q < 0 {
+			count++
+		}
+	}
+	return count
+}
+
+// SturmBisection локализует все собственные значения симметричной
+// трёхдиагональной матрицы бисекцией до отрезков длины не больше tol.
+// Интервалы упорядочены по возрастанию собственных значений.
+func SturmBisection(d, e []float64, tol float64) ([]Interval, error) {
+	n := len(d)
+	if n == 0 || len(e) != n-1 {
+		return nil, fmt.Errorf("некорректные размеры диагоналей")
+	}
+	if tol <= 0 {
+		return nil, fmt.Errorf("точность должна быть положительной")
+	}
+
+	lo, hi := math.Inf(1), math.Inf(-1)
+	for i := 0; i < n; i++ {
+		r := 0.0
+		if i > 0 {
+			r += math.Abs(e[i-1])
+		}
+		if i < n-1 {
+			r += math.Abs(e[i])
+		}
+		lo = math.Min(lo, d[i]-r)
+		hi = math.Max(hi, d[i]+r)
+	}
+	pad := tol + 1e-12*math.Max(math.Abs(lo), math.Abs(hi))
+	lo -= pad
+	hi += pad
+
+	intervals := make([]Interval, n)
+	for k := 0; k < n; k++ {
+		a, b := lo, hi
+		for b-a > tol {
+			mid := (a + b) / 2
+			if mid == a || mid == b {
+				break
+			}
+			if SturmCount(d, e, mid) > k {
+				b = mid
+			} else {
+				a = mid
+			}
+		}
+		intervals[k] = Interval{Lo: a, Hi: b}
+	}
+	return intervals, nil
+}
+
+// SymmetricIntervals строит сертифицированные интервалы для собственных
+// значений произвольной симметричной матрицы.
+func SymmetricIntervals(A [][]float64, tol float64) ([]Interval, error) {
+	d, e, err := Tridiagonalize(A)
+	if err != nil {
+		return nil, err
+	}
+	return SturmBisection(d, e, tol)
+}
@@ -0,0 +1,85 @@
+package spectrum
+
+import (
+	"bufio"
+	"fmt"
+	"math"
+	"os"
+)
+
+const (
+	svgSize    = 600.0
+	svgPadding = 40.0
+)
+
+// WriteSVG рисует строчные (синие) и столбцовые (зелёные, пунктир) круги
+// Гершгорина и вычисленные собственные значения (красные точки).
+func WriteSVG(filename string, rows, cols []Disc, eigenvalues []complex128) error {
+	file, err := os.Create(filename)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	xMin, xMax := math.Inf(1), math.Inf(-1)
+	yMax := 0.0
+	for _, discs := range [][]Disc{rows, cols} {
+		lo, hi := bounds(discs)
+		xMin = math.Min(xMin, lo)
+		xMax = math.Max(xMax, hi)
+		for _, d := range discs {
+			yMax = math.Max(yMax, d.Radius)
+		}
+	}
+	for _, z := range eigenvalues {
+		xMin = math.Min(xMin, real(z))
+		xMax = math.Max(xMax, real(z))
+		yMax = math.Max(yMax, math.Abs(imag(z)))
+	}
+	if math.IsInf(xMin, 0) {
+		xMin, xMax = -1, 1
+	}
+
+	// Одинаковый масштаб по осям, чтобы круги не искажались
+	cx := (xMin + xMax) / 2
+	half := math.Max((xMax-xMin)/2, yMax)
+	if half == 0 {
+		half = 1
+	}
+	half *= 1.05
+	scale := (svgSize - 2*svgPadding) / (2 * half)
+	px := func(x float64) float64 { return svgSize/2 + (x-cx)*scale }
+	py := func(y float64) float64 { return svgSize/2 - y*scale }
+
+	w := bufio.NewWriter(file)
+	fmt.Fprintf(w, "<svg xmlns=\"http://www.w3.org/2000/svg\" width=\"%.0f\" height=\"%.0f\" viewBox=\"0 0 %.0f %.0f\">\n",
+		svgSize, svgSize, svgSize, svgSize)
+	fmt.Fprintln(w, "<rect width=\"100%\" height=\"100%\" fill=\"white\"/>")
+
+	// Оси
+	fmt.Fprintf(w, "<line x1=\"0\" y1=\"%.2f\" x2=\"%.0f\" y2=\"%.2f\" stroke=\"gray\"/>\n", py(0), svgSize, py(0))
+	if x0 := px(0); x0 >= 0 && x0 <= svgSize {
+		fmt.Fprintf(w, "<line x1=\"%.2f\" y1=\"0\" x2=\"%.2f\" y2=\"%.0f\" stroke=\"gray\"/>\n", x0, x0, svgSize)
+	}
+	fmt.Fprintf(w, "<text x=\"5\" y=\"%.2f\" font-size=\"12\">%.3g</text>\n", py(0)-4, cx-half)
+	fmt.Fprintf(w, "<text x=\"%.0f\" y=\"%.2f\" font-size=\"12\" text-anchor=\"end\">%.3g</text>\n", svgSize-5, py(0)-4, cx+half)
+
+	for _, d := range rows {
+		fmt.Fprintf(w, "<circle cx=\"%.2f\" cy=\"%.2f\" r=\"%.2f\" fill=\"blue\" fill-opacity=\"0.08\" stroke=\"blue\"/>\n",
+			px(d.Center), py(0), d.Radius*scale)
+	}
+	for _, d := range cols {
+		fmt.Fprintf(w, "<circle cx=\"%.2f\" cy=\"%.2f\" r=\"%.2f\" fill=\"none\" stroke=\"green\" stroke-dasharray=\"6,4\"/>\n",
+			px(d.Center), py(0), d.Radius*scale)
+	}
+	for _, z := range eigenvalues {
+		fmt.Fprintf(w, "<circle cx=\"%.2f\" cy=\"%.2f\" r=\"4\" fill=\"red\"/>\n", px(real(z)), py(imag(z)))
+	}
+
+	fmt.Fprintln(w, "<text x=\"10\" y=\"20\" font-size=\"14\" fill=\"blue\">круги по строкам</text>")
+	fmt.Fprintln(w, "<text x=\"10\" y=\"38\" font-size=\"14\" fill=\"green\">круги по столбцам</text>")
+	fmt.Fprintln(w, "<text x=\"10\" y=\"56\" font-size=\"14\" fill=\"red\">собственные значения</text>")
+	fmt.Fprintln(w, "</svg>")
+
+	return w.Flush()
+}
@@ -0,0 +1,249 @@
+package spline
+
+import (
+	"fmt"
+	"math"
+)
+
+// Extrapolation — поведение сплайна вне отрезка [x₀, xₙ].
+type Extrapolation int
+
+const (
+	ExtrapolateError  Extrapolation = iota // вне отрезка — ошибка (NaN для Evaluate и производных)
+	ExtrapolateClamp                       // значение в ближайшем узле, производные равны нулю
+	ExtrapolateLinear                      // касательная в ближайшем конце
+	ExtrapolateCubic                       // продолжение крайнего кубического многочлена
+)
+
+func (e Extrapolation) String() string {
+	switch e {
+	case ExtrapolateError:
+		return "ошибка"
+	case ExtrapolateClamp:
+		return "значение на конце"
+	case ExtrapolateLinear:
+		return "линейная"
+	case ExtrapolateCubic:
+		return "кубическая"
+	}
+	return fmt.Sprintf("Extrapolation(%d)", int(e))
+}
+
+// SetExtrapolation задаёт режим экстраполяции; по умолчанию продолжается
+// крайний кубический многочлен.
+func (s *Spline) SetExtrapolation(mode Extrapolation) error {
+	if mode < ExtrapolateError || mode > ExtrapolateCubic {
+		return fmt.Errorf("неизвестный режим экстраполяции %v", mode)
+	}
+	s.extrapolation = mode
+	return nil
+}
+
+func (s *Spline) Extrapolation() Extrapolation {
+	return s.extrapolation
+}
+
+// Domain возвращает отрезок интерполяции [x₀, xₙ].
+func (s *Spline) Domain() (float64, float64) {
+	return s.xi[0], s.xi[len(s.xi)-1]
+}
+
+// Evaluate вычисляет S(x).
+func (s *Spline) Evaluate(x float64) float64 {
+	if s.outside(x) {
+		return s.extrapolate(x, 0)
+	}
+	i := s.interval(x)
+	dx := x - s.xi[i]
+	return s.ai[i] + dx*(s.bi[i]+dx*(s.ci[i]+dx*s.di[i]))
+}
+
+// EvaluateChecked вычисляет S(x) и возвращает ошибку, если x вне [x₀, xₙ]
+// при режиме ExtrapolateError.
+func (s *Spline) EvaluateChecked(x float64) (float64, error) {
+	if err := s.checkDomain(x, x); err != nil {
+		return 0, err
+	}
+	return s.Evaluate(x), nil
+}
+
+// Derivative вычисляет S'(x).
+func (s *Spline) Derivative(x float64) float64 {
+	if s.outside(x) {
+		return s.extrapolate(x, 1)
+	}
+	i := s.interval(x)
+	dx := x - s.xi[i]
+	return s.bi[i] + dx*(2*s.ci[i]+3*dx*s.di[i])
+}
+
+// SecondDerivative вычисляет S”(x); в узлах берётся значение справа.
+func (s *Spline) SecondDerivative(x float64) float64 {
+	if s.outside(x) {
+		return s.extrapolate(x, 2)
+	}
+	i := s.interval(x)
+	return 2*s.ci[i] + 6*s.di[i]*(x-s.xi[i])
+}
+
+// EvaluateSorted вычисляет S в неубывающих точках xs и записывает
+// значения в out (при out = nil выделяется новый срез). Отрезок для
+// очередной точки ищется продвижением от предыдущего, поэтому весь проход
+// стоит O(len(xs) + n) вместо O(len(xs)·log n).
+func (s *Spline) EvaluateSorted(xs, out []float64) ([]float64, error) {
+	if out == nil {
+		out = make([]float64, len(xs))
+	}
+	if len(out) != len(xs) {
+		return nil, fmt.Errorf("длина out (%d) не совпадает с числом точек (%d)", len(out), len(xs))
+	}
+	if len(xs) == 0 {
+		return out, nil
+	}
+	if err := s.checkDomain(xs[0], xs[len(xs)-1]); err != nil {
+		return nil, err
+	}
+
+	last := len(s.ai) - 1
+	i := s.interval(xs[0])
+	for k, x := range xs {
+		if k > 0 && x < xs[k-1] {
+			return nil, fmt.Errorf("точки не упорядочены: x[%d] = %g < x[%d] = %g", k, x, k-1, xs[k-1])
+		}
+		if s.outside(x) {
+			out[k] = s.extrapolate(x, 0)
+			continue
+		}
+		for i < last && s.xi[i+1] <= x {
+			i++
+		}
+		dx := x - s.xi[i]
+		out[k] = s.ai[i] + dx*(s.bi[i]+dx*(s.ci[i]+dx*s.di[i]))
+	}
+	return out, nil
+}
+
+// Integrate вычисляет ∫ S(x) dx по [a, b] точно: интегралы по [x₀, x_i]
+// накоплены при построении, так что остаётся двоичный поиск двух отрезков.
+// Вне [x₀, xₙ] интегрируется продолжение согласно режиму экстраполяции.
+func (s *Spline) Integrate(a, b float64) (float64, error) {
+	if a > b {
+		v, err := s.Integrate(b, a)
+		return -v, err
+	}
+	if err := s.checkDomain(a, b); err != nil {
+		return 0, err
+	}
+
+	x0, xn := s.Domain()
+	total := 0.0
+	if a < x0 {
+		total += s.integrateOutside(a, math.Min(b, x0))
+		a = x0
+	}
+	if b > xn {
+		total += s.integrateOutside(math.Max(a, xn), b)
+		b = xn
+	}
+	if a < b {
+		total += s.cumulative(b) - s.cumulative(a)
+	}
+	return total, nil
+}
+
+// cumulative — интеграл по [x₀, x] для x из [x₀, xₙ].
+func (s *Spline) cumulative(x float64) float64 {
+	i := s.interval(x)
+	return s.integrals[i] + s.primitive(i, x-s.xi[i])
+}
+
+// primitive — первообразная многочлена отрезка i, равная нулю в x_i.
+func (s *Spline) primitive(i int, dx float64) float64 {
+	return dx * (s.ai[i] + dx*(s.bi[i]/2+dx*(s.ci[i]/3+dx*s.di[i]/4)))
+}
+
+// integrateOutside интегрирует продолжение сплайна по [lo, hi], лежащему
+// целиком левее x₀ или правее xₙ.
+func (s *Spline) integrateOutside(lo, hi float64) float64 {
+	i, end := s.edge(lo)
+	if s.extrapolation == ExtrapolateCubic {
+		return s.primitive(i, hi-s.xi[i]) - s.primitive(i, lo-s.xi[i])
+	}
+	value, d1, _ := s.piece(i, end-s.xi[i])
+	if s.extrapolation == ExtrapolateClamp {
+		return value * (hi - lo)
+	}
+	return value*(hi-lo) + d1*((hi-end)*(hi-end)-(lo-end)*(lo-end))/2
+}
+
+// interval возвращает номер отрезка i, для которого x_i ≤ x < x_{i+1};
+// точки левее x₀ относятся к первому отрезку, правее xₙ — к последнему.
+func (s *Spline) interval(x float64) int {
+	lo, hi := 0, len(s.ai)-1
+	for lo < hi {
+		mid := int(uint(lo+hi+1) >> 1)
+		if s.xi[mid] <= x {
+			lo = mid
+		} else {
+			hi = mid - 1
+		}
+	}
+	return lo
+}
+
+// outside сообщает, нужно ли для x применять режим экстраполяции; при
+// кубической экстраполяции крайние многочлены вычисляются как обычно.
+func (s *Spline) outside(x float64) bool {
+	return s.extrapolation != ExtrapolateCubic && (x < s.xi[0] || x > s.xi[len(s.xi)-1])
+}
+
+// checkDomain возвращает ошибку, если при режиме ExtrapolateError [a, b]
+// выходит за [x₀, xₙ].
+func (s *Spline) checkDomain(a, b float64) error {
+	x0, xn := s.Domain()
+	if s.extrapolation != ExtrapolateError || (a >= x0 && b <= xn) {
+		return nil
+	}
+	if a == b {
+		return fmt.Errorf("точка %g вне отрезка интерполяции [%g, %g]", a, x0, xn)
+	}
+	return fmt.Errorf("точки [%g, %g] выходят за отрезок интерполяции [%g, %g]", a, b, x0, xn)
+}
+
+// edge возвращает крайний отрезок и концевой узел со стороны x: левые при
+// x < x₀, иначе правые. Номер отрезка не годится для выбора стороны: при
+// двух узлах первый отрезок одновременно и последний.
+func (s *Spline) edge(x float64) (int, float64) {
+	if x < s.xi[0] {
+		return 0, s.xi[0]
+	}
+	return len(s.ai) - 1, s.xi[len(s.xi)-1]
+}
+
+// extrapolate вычисляет производную порядка order (0, 1, 2) вне [x₀, xₙ]
+// для режимов ExtrapolateError, ExtrapolateClamp и ExtrapolateLinear.
+// Ошибка превращается в NaN.
+func (s *Spline) extrapolate(x float64, order int) float64 {
+	if s.extrapolation == ExtrapolateError {
+		return math.NaN()
+	}
+	i, end := s.edge(x)
+	value, d1, _ := s.piece(i, end-s.xi[i])
+	switch {
+	case order == 0 && s.extrapolation == ExtrapolateClamp:
+		return value
+	case order == 0:
+		return value + d1*(x-end)
+	case order == 1 && s.extrapolation == ExtrapolateLinear:
+		return d1
+	}
+	return 0
+}
+
+// piece вычисляет S, S', S” многочлена отрезка i при dx = x − x_i.
+func (s *Spline) piece(i int, dx float64) (float64, float64, float64) {
+	value := s.ai[i] + dx*(s.bi[i]+dx*(s.ci[i]+dx*s.di[i]))
+	d1 := s.bi[i] + dx*(2*s.ci[i]+3*dx*s.di[i])
+	d2 := 2*s.ci[i] + 6*s.di[i]*dx
+	return value, d1, d2
+}
@@ -0,0 +1,312 @@
+package spline
+
+import (
+	"fmt"
+	"math"
+)
+
+// BoundaryKind — тип граничных условий кубического сплайна.
+type BoundaryKind int
+
+const (
+	Natural          BoundaryKind = iota // S''(x₀) = S''(xₙ) = 0
+	Clamped                              // заданы S'(x₀) = Left, S'(xₙ) = Right
+	SecondDerivative                     // заданы S''(x₀) = Left, S''(xₙ) = Right
+	NotAKnot                             // S''' непрерывна в x₁ и xₙ₋₁
+	Periodic                             // S, S', S'' совпадают в x₀ и xₙ
+)
+
+func (k BoundaryKind) String() string {
+	switch k {
+	case Natural:
+		return "естественные"
+	case Clamped:
+		return "заданы первые производные"
+	case SecondDerivative:
+		return "заданы вторые производные"
+	case NotAKnot:
+		return "условие «не узла»"
+	case Periodic:
+		return "периодические"
+	}
+	return fmt.Sprintf("BoundaryKind(%d)", int(k))
+}
+
+// Boundary — граничные условия; Left и Right используются для Clamped и
+// SecondDerivative.
+type Boundary struct {
+	Kind        BoundaryKind
+	Left, Right float64
+}
+
+// Допуск проверок сплайна (относительно масштаба значений)
+const checkTolerance = 1e-9
+
+// Spline — кубический сплайн: на отрезке [x_i, x_{i+1}]
+// S(x) = a_i + b_i·dx + c_i·dx² + d_i·dx³, dx = x − x_i.
+// ci хранится для всех узлов: c_i = S”(x_i)/2.
+type Spline struct {
+	xi []float64
+	fi []float64
+	ai []float64
+	bi []float64
+	ci []float64
+	di []float64
+
+	boundary      Boundary
+	extrapolation Extrapolation
+	// integrals[i] — интеграл сплайна по [x₀, x_i]
+	integrals []float64
+}
+
+// BuildNaturalSpline строит сплайн с естественными граничными условиями.
+func BuildNaturalSpline(xi, fi []float64) (*Spline, error) {
+	return Build(xi, fi, Boundary{Kind: Natural})
+}
+
+// Build строит кубический сплайн по возрастающим узлам xi и значениям fi.
+// Вторые производные в узлах находятся из трёхдиагональной системы
+// (циклической для периодических условий).
+func Build(xi, fi []float64, bc Boundary) (*Spline, error) {
+	n := len(xi)
+	if n < 2 {
+		return nil, fmt.Errorf("для построения сплайна нужно минимум 2 точки")
+	}
+	if len(fi) != n {
+		return nil, fmt.Errorf("число узлов (%d) не совпадает с числом значений (%d)", n, len(fi))
+	}
+	h := calculateSteps(xi)
+	for i, hi := range h {
+		if hi <= 0 {
+			return nil, fmt.Errorf("узлы должны строго возрастать (x_%d = %g, x_%d = %g)", i, xi[i], i+1, xi[i+1])
+		}
+	}
+
+	var ci []float64
+	var err error
+	switch bc.Kind {
+	case Natural:
+		ci, err = solveSecondDerivative(fi, h, 0, 0)
+	case SecondDerivative:
+		ci, err = solveSecondDerivative(fi, h, bc.Left, bc.Right)
+	case Clamped:
+		ci, err = solveClamped(fi, h, bc.Left, bc.Right)
+	case NotAKnot:
+		ci, err = solveNotAKnot(fi, h)
+	case Periodic:
+		if math.Abs(fi[0]-fi[n-1]) > checkTolerance*math.Max(1, math.Abs(fi[0])) {
+			return nil, fmt.Errorf("для периодического сплайна нужно f(x₀) = f(xₙ), получено %g и %g", fi[0], fi[n-1])
+		}
+		ci, err = solvePeriodic(fi, h)
+	default:
+		return nil, fmt.Errorf("неизвестный тип граничных условий %v", bc.Kind)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("граничные условия «%v»: %w", bc.Kind, err)
+	}
+
+	ai, bi, di := calculateSplineCoefficients(fi, h, ci)
+	s := &Spline{
+		xi:            append([]float64(nil), xi...),
+		fi:            append([]float64(nil), fi...),
+		ai:            ai,
+		bi:            bi,
+		ci:            ci,
+		di:            di,
+		boundary:      bc,
+		extrapolation: ExtrapolateCubic,
+	}
+	s.integrals = make([]float64, n)
+	for i := range h {
+		s.integrals[i+1] = s.integrals[i] + s.primitive(i, h[i])
+	}
+	return s, nil
+}
+
+func calculateSteps(xi []float64) []float64 {
+	h := make([]float64, len(xi)-1)
+	for i := 0; i < len(h); i++ {
+		h[i] = xi[i+1] - xi[i]
+	}
+	return h
+}
+
+// interiorSystem заполняет строки j = 1, …, n−1 системы для c_j:
+// h_{j−1}·c_{j−1} + 2(h_{j−1} + h_j)·c_j + h_j·c_{j+1} = 3(Δ_j − Δ_{j−1}),
+// Δ_j = (f_{j+1} − f_j)/h_j. Строки 0 и n остаются для граничных условий.
+func interiorSystem(fi, h []float64) (lower, diag, upper, rhs []float64) {
+	n := len(h)
+	lower = make([]float64, n)
+	diag = make([]float64, n+1)
+	upper = make([]float64, n)
+	rhs = make([]float64, n+1)
+	for j := 1; j < n; j++ {
+		lower[j-1] = h[j-1]
+		diag[j] = 2 * (h[j-1] + h[j])
+		upper[j] = h[j]
+		rhs[j] = 3 * ((fi[j+1]-fi[j])/h[j] - (fi[j]-fi[j-1])/h[j-1])
+	}
+	return lower, diag, upper, rhs
+}
+
+func solveSecondDerivative(fi, h []float64, left, right float64) ([]float64, error) {
+	n := len(h)
+	lower, diag, upper, rhs := interiorSystem(fi, h)
+	diag[0], rhs[0] = 1, left/2
+	diag[n], rhs[n] = 1, right/2
+	return thomas(lower, diag, upper, rhs)
+}
+
+// solveClamped: S'(x₀) = s₀ даёт 2h₀c₀ + h₀c₁ = 3(Δ₀ − s₀),
+// S'(xₙ) = sₙ даёт hₙ₋₁cₙ₋₁ + 2hₙ₋₁cₙ = 3(sₙ − Δₙ₋₁).
+func solveClamped(fi, h []float64, left, right float64) ([]float64, error) {
+	n := len(h)
+	lower, diag, upper, rhs := interiorSystem(fi, h)
+	diag[0], upper[0] = 2*h[0], h[0]
+	rhs[0] = 3 * ((fi[1]-fi[0])/h[0] - left)
+	lower[n-1], diag[n] = h[n-1], 2*h[n-1]
+	rhs[n] = 3 * (right - (fi[n]-fi[n-1])/h[n-1])
+	return thomas(lower, diag, upper, rhs)
+}
+
+// solveNotAKnot: условия d₀ = d₁ и dₙ₋₂ = dₙ₋₁ выражают c₀ и cₙ через
+// соседние коэффициенты; после подстановки система для c₁, …, cₙ₋₁
+// остаётся трёхдиагональной. По трём точкам строится парабола, по двум —
+// прямая.
+func solveNotAKnot(fi, h []float64) ([]float64, error) {
+	n := len(h)
+	switch n {
+	case 1:
+		return make([]float64, 2), nil
+	case 2:
+		c := ((fi[2]-fi[1])/h[1] - (fi[1]-fi[0])/h[0]) / (h[0] + h[1])
+		return []float64{c, c, c}, nil
+	}
+
+	lower, diag, upper, rhs := interiorSystem(fi, h)
+	// Система для c₁, …, cₙ₋₁
+	lower, diag, upper, rhs = lower[1:n-1], diag[1:n], upper[1:n-1], rhs[1:n]
+	diag[0] += h[0] * (h[0] + h[1]) / h[1]
+	upper[0] -= h[0] * h[0] / h[1]
+	diag[n-2] += h[n-1] * (h[n-2] + h[n-1]) / h[n-2]
+	lower[n-3] -= h[n-1] * h[n-1] / h[n-2]
+
+	inner, err := thomas(lower, diag, upper, rhs)
+	if err != nil {
+		return nil, err
+	}
+	ci := make([]float64, n+1)
+	copy(ci[1:n], inner)
+	ci[0] = ((h[0]+h[1])*ci[1] - h[0]*ci[2]) / h[1]
+	ci[n] = ((h[n-2]+h[n-1])*ci[n-1] - h[n-1]*ci[n-2]) / h[n-2]
+	return ci, nil
+}
+
+// solvePeriodic: неизвестные c₀, …, cₙ₋₁ (cₙ = c₀), строки замыкаются
+// циклически, h₋₁ = hₙ₋₁.
+func solvePeriodic(fi, h []float64) ([]float64, error) {
+	n := len(h)
+	if n < 2 {
+		return nil, fmt.Errorf("для периодического сплайна нужно минимум 3 точки")
+	}
+	slope := func(j int) float64 { return (fi[j+1] - fi[j]) / h[j] }
+
+	lower := make([]float64, n-1)
+	diag := make([]float64, n)
+	upper := make([]float64, n-1)
+	rhs := make([]float64, n)
+	for j := 0; j < n; j++ {
+		prev := (j + n - 1) % n
+		diag[j] = 2 * (h[prev] + h[j])
+		rhs[j] = 3 * (slope(j) - slope(prev))
+		if j > 0 {
+			lower[j-1] = h[j-1]
+		}
+		if j < n-1 {
+			upper[j] = h[j]
+		}
+	}
+	c, err := cyclicThomas(lower, diag, upper, rhs, h[n-1], h[n-1])
+	if err != nil {
+		return nil, err
+	}
+	return append(c, c[0]), nil
+}
+
+func calculateSplineCoefficients(fi, h, ci []float64) ([]float64, []float64, []float64) {
+	n := len(h)
+	ai := make([]float64, n)
+	bi := make([]float64, n)
+	di := make([]float64, n)
+	for i := 0; i < n; i++ {
+		ai[i] = fi[i]
+		bi[i] = (fi[i+1]-fi[i])/h[i] - h[i]*(2*ci[i]+ci[i+1])/3
+		di[i] = (ci[i+1] - ci[i]) / (3 * h[i])
+	}
+	return ai, bi, di
+}
+
+// Boundary возвращает граничные условия, по которым построен сплайн.
+func (s *Spline) Boundary() Boundary {
+	return s.boundary
+}
+
+// Nodes возвращает копии узлов x_i и значений f_i.
+func (s *Spline) Nodes() ([]float64, []float64) {
+	return append([]float64(nil), s.xi...), append([]float64(nil), s.fi...)
+}
+
+// Coefficients возвращает копии коэффициентов a_i, b_i, c_i, d_i по отрезкам.
+func (s *Spline) Coefficients() (a, b, c, d []float64) {
+	n := len(s.ai)
+	clone := func(v []float64) []float64 { return append([]float64(nil), v[:n]...) }
+	return clone(s.ai), clone(s.bi), clone(s.ci), clone(s.di)
+}
+
+func nearlyEqual(a, b float64) bool {
+	return math.Abs(a-b) <= checkTolerance*math.Max(1, math.Max(math.Abs(a), math.Abs(b)))
+}
+
+// CheckNodeValues проверяет, что сплайн проходит через все узлы.
+func (s *Spline) CheckNodeValues() error {
+	for i, x := range s.xi {
+		if val := s.Evaluate(x); !nearlyEqual(val, s.fi[i]) {
+			return fmt.Errorf("узел %d: x = %.4f, сплайн = %.6f, f_i = %.6f", i, x, val, s.fi[i])
+		}
+	}
+	return nil
+}
+
+// CheckBoundaryConditions проверяет граничные условия того типа, по
+// которому построен сплайн.
+func (s *Spline) CheckBoundaryConditions() error {
+	last := len(s.ai) - 1
+	value0, d1Start, d2Start := s.piece(0, 0)
+	valueN, d1End, d2End := s.piece(last, s.xi[last+1]-s.xi[last])
+
+	bc := s.boundary
+	switch bc.Kind {
+	case Natural, SecondDerivative:
+		if bc.Kind == Natural {
+			bc.Left, bc.Right = 0, 0
+		}
+		if !nearlyEqual(d2Start, bc.Left) || !nearlyEqual(d2End, bc.Right) {
+			return fmt.Errorf("S''(x₀) = %.6g, S''(xₙ) = %.6g, ожидалось %.6g и %.6g", d2Start, d2End, bc.Left, bc.Right)
+		}
+	case Clamped:
+		if !nearlyEqual(d1Start, bc.Left) || !nearlyEqual(d1End, bc.Right) {
+			return fmt.Errorf("S'(x₀) = %.6g, S'(xₙ) = %.6g, ожидалось %.6g и %.6g", d1Start, d1End, bc.Left, bc.Right)
+		}
+	case NotAKnot:
+		if last >= 1 && (!nearlyEqual(s.di[0], s.di[1]) || !nearlyEqual(s.di[last-1], s.di[last])) {
+			return fmt.Errorf("S''' разрывна: d₀ = %.6g, d₁ = %.6g, dₙ₋₂ = %.6g, dₙ₋₁ = %.6g",
+				s.di[0], s.di[1], s.di[last-1], s.di[last])
+		}
+	case Periodic:
+		if !nearlyEqual(value0, valueN) || !nearlyEqual(d1Start, d1End) || !nearlyEqual(d2Start, d2End) {
+			return fmt.Errorf("S, S', S'' на концах: (%.6g, %.6g, %.6g) и (%.6g, %.6g, %.6g)",
+				value0, d1Start, d2Start, valueN, d1End, d2End)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,81 @@
+package spline
+
+import "fmt"
+
+// thomas решает трёхдиагональную систему методом прогонки: a — поддиагональ
+// (n−1), b — диагональ (n), c — наддиагональ (n−1), d — правая часть.
+func thomas(a, b, c, d []float64) ([]float64, error) {
+	n := len(d)
+	if n == 0 || len(a) != n-1 || len(b) != n || len(c) != n-1 {
+		return nil, fmt.Errorf("некорректные размеры трёхдиагональной системы")
+	}
+
+	cp := make([]float64, n)
+	dp := make([]float64, n)
+	if b[0] == 0 {
+		return nil, fmt.Errorf("деление на ноль на шаге 0")
+	}
+	if n > 1 {
+		cp[0] = c[0] / b[0]
+	}
+	dp[0] = d[0] / b[0]
+
+	for i := 1; i < n; i++ {
+		denom := b[i] - a[i-1]*cp[i-1]
+		if denom == 0 {
+			return nil, fmt.Errorf("деление на ноль на шаге %d", i)
+		}
+		if i < n-1 {
+			cp[i] = c[i] / denom
+		}
+		dp[i] = (d[i] - a[i-1]*dp[i-1]) / denom
+	}
+
+	x := make([]float64, n)
+	x[n-1] = dp[n-1]
+	for i := n - 2; i >= 0; i-- {
+		x[i] = dp[i] - cp[i]*x[i+1]
+	}
+	return x, nil
+}
+
+// cyclicThomas решает циклическую трёхдиагональную систему: к
+// трёхдиагональной матрице добавлены угловые элементы A[0][n−1] = beta и
+// A[n−1][0] = alpha. Угловые элементы выделяются в поправку ранга один,
+// и по формуле Шермана–Моррисона решаются две обычные системы.
+func cyclicThomas(a, b, c, d []float64, alpha, beta float64) ([]float64, error) {
+	n := len(d)
+	if n < 2 || len(a) != n-1 || len(b) != n || len(c) != n-1 {
+		return nil, fmt.Errorf("некорректные размеры циклической системы")
+	}
+	if n == 2 {
+		// Угловые элементы совпадают с внедиагональными
+		return thomas([]float64{a[0] + alpha}, b, []float64{c[0] + beta}, d)
+	}
+
+	gamma := -b[0]
+	bb := append([]float64(nil), b...)
+	bb[0] -= gamma
+	bb[n-1] -= alpha * beta / gamma
+
+	x, err := thomas(a, bb, c, d)
+	if err != nil {
+		return nil, err
+	}
+	u := make([]float64, n)
+	u[0], u[n-1] = gamma, alpha
+	z, err := thomas(a, bb, c, u)
+	if err != nil {
+		return nil, err
+	}
+
+	den := 1 + z[0] + beta*z[n-1]/gamma
+	if den == 0 {
+		return nil, fmt.Errorf("циклическая система вырождена")
+	}
+	fact := (x[0] + beta*x[n-1]/gamma) / den
+	for i := range x {
+		x[i] -= fact * z[i]
+	}
+	return x, nil
+}
@@ -0,0 +1,342 @@
+package svd_decompose
+
+import (
+	"fmt"
+	"math"
+	"sort"
+)
+
+const maxSweeps = 100
+
+// MachineEpsilon — машинный эпсилон float64, 2⁻⁵².
+const MachineEpsilon = 2.220446049250313e-16
+
+// SVDDecomposition вычисляет сингулярное разложение A = U·Σ·Vᵀ
+// односторонним методом Якоби (Хестенса). Для матрицы m×n возвращаются
+// U размера m×k, σ длины k и Vᵀ размера k×n, где k = min(m, n).
+// Сингулярные числа упорядочены по убыванию.
+func SVDDecomposition(A [][]float64) (U [][]float64, sigma []float64, VT [][]float64, err error) {
+	m := len(A)
+	if m == 0 || len(A[0]) == 0 {
+		return nil, nil, nil, fmt.Errorf("матрица пуста")
+	}
+	n := len(A[0])
+	for _, row := range A {
+		if len(row) != n {
+			return nil, nil, nil, fmt.Errorf("строки матрицы имеют разную длину")
+		}
+	}
+
+	// Для широких матриц раскладываем Aᵀ = V·Σ·Uᵀ
+	if m < n {
+		Ut, s, Vt, err := SVDDecomposition(transpose(A))
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		return transpose(Vt), s, transpose(Ut), nil
+	}
+
+	W := make([][]float64, m)
+	for i := range A {
+		W[i] = make([]float64, n)
+		copy(W[i], A[i])
+	}
+	V := identity(n)
+
+	// Столбцы считаются ортогональными, если |γ| ≤ m·ε·√(αβ); численно
+	// нулевые столбцы (норма меньше m·ε от наибольшей) не вращаются — их
+	// шум округления никогда не пройдёт проверку ортогональности.
+	tol := float64(m) * MachineEpsilon
+	converged := false
+	for sweep := 0; sweep < maxSweeps && !converged; sweep++ {
+		converged = true
+		maxNorm := 0.0
+		for j := 0; j < n; j++ {
+			norm := 0.0
+			for i := 0; i < m; i++ {
+				norm += W[i][j] * W[i][j]
+			}
+			maxNorm = math.Max(maxNorm, norm)
+		}
+		negligible := tol * tol * maxNorm
+
+		for p := 0; p < n-1; p++ {
+			for q := p + 1; q < n; q++ {
+				alpha, beta, gamma := 0.0, 0.0, 0.0
+				for i := 0; i < m; i++ {
+					alpha += W[i][p] * W[i][p]
+					beta += W[i][q] * W[i][q]
+					gamma += W[i][p] * W[i][q]
+				}
+				if alpha <= negligible || beta <= negligible || math.Abs(gamma) <= tol*math.Sqrt(alpha*beta) {
+					continue
+				}
+				converged = false
+
+				zeta := (beta - alpha) / (2 * gamma)
+				t := math.Copysign(1, zeta) / (math.Abs(zeta) + math.Sqrt(1+zeta*zeta))
+				c := 1 / math.Sqrt(1+t*t)
+				s := c * t
+
+				for i := 0; i < m; i++ {
+					wp, wq := W[i][p], W[i][q]
+					W[i][p] = c*wp - s*wq
+					W[i][q] = s*wp + c*wq
+				}
+				for i := 0; i < n; i++ {
+					vp, vq := V[i][p], V[i][q]
+					V[i][p] = c*vp - s*vq
+					V[i][q] = s*vp + c*vq
+				}
+			}
+		}
+	}
+	if !converged {
+		return nil, nil, nil, fmt.Errorf("метод Якоби не сошёлся за %d проходов", maxSweeps)
+	}
+
+	sigma = make([]float64, n)
+	for j := 0; j < n; j++ {
+		norm := 0.0
+		for i := 0; i < m; i++ {
+			norm += W[i][j] * W[i][j]
+		}
+		sigma[j] = math.Sqrt(norm)
+	}
+
+	order := make([]int, n)
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(a, b int) bool { return sigma[order[a]] > sigma[order[b]] })
+
+	tol = DefaultTolerance(m, n, sigma)
+	U = make([][]float64, m)
+	for i := range U {
+		U[i] = make([]float64, n)
+	}
+	VT = make([][]float64, n)
+	sorted := make([]float64, n)
+	for k, j := range order {
+		sorted[k] = sigma[j]
+		if sigma[j] > tol {
+			for i := 0; i < m; i++ {
+				U[i][k] = W[i][j] / sigma[j]
+			}
+		}
+		VT[k] = make([]float64, n)
+		for i := 0; i < n; i++ {
+			VT[k][i] = V[i][j]
+		}
+	}
+	completeColumns(U, sorted, tol)
+
+	return U, sorted, VT, nil
+}
+
+// completeColumns дополняет столбцы U, соответствующие нулевым сингулярным
+// числам, до ортонормированного набора.
+func completeColumns(U [][]float64, sigma []float64, tol float64) {
+	m := len(U)
+	next := 0
+	for k := range sigma {
+		if sigma[k] > tol {
+			continue
+		}
+		for ; next < m; next++ {
+			v := make([]float64, m)
+			v[next] = 1
+			for j := range sigma {
+				if j == k || (sigma[j] <= tol && j > k) {
+					continue
+				}
+				dot := 0.0
+				for i := 0; i < m; i++ {
+					dot += U[i][j] * v[i]
+				}
+				for i := 0; i < m; i++ {
+					v[i] -= dot * U[i][j]
+				}
+			}
+			norm := 0.0
+			for i := 0; i < m; i++ {
+				norm += v[i] * v[i]
+			}
+			norm = math.Sqrt(norm)
+			if norm > 1e-8 {
+				for i := 0; i < m; i++ {
+					U[i][k] = v[i] / norm
+				}
+				next++
+				break
+			}
+		}
+	}
+}
+
+// DefaultTolerance — порог, ниже которого сингулярное число считается нулевым.
+func DefaultTolerance(m, n int, sigma []float64) float64 {
+	if len(sigma) == 0 {
+		return 0
+	}
+	maxSigma := 0.0
+	for _, s := range sigma {
+		maxSigma = math.Max(maxSigma, s)
+	}
+	return float64(max(m, n)) * maxSigma * MachineEpsilon
+}
+
+// Rank возвращает численный ранг — количество сингулярных чисел больше tol.
+func Rank(sigma []float64, tol float64) int {
+	rank := 0
+	for _, s := range sigma {
+		if s > tol {
+			rank++
+		}
+	}
+	return rank
+}
+
+// ConditionNumber возвращает число обусловленности в 2-норме σ_max/σ_min.
+// Для вырожденной матрицы возвращается +Inf.
+func ConditionNumber(sigma []float64) float64 {
+	if len(sigma) == 0 {
+		return math.NaN()
+	}
+	smin := sigma[len(sigma)-1]
+	if smin == 0 {
+		return math.Inf(1)
+	}
+	return sigma[0] / smin
+}
+
+// PseudoInverse вычисляет псевдообратную матрицу Мура–Пенроуза A⁺ = V·Σ⁺·Uᵀ.
+func PseudoInverse(A [][]float64, tol float64) ([][]float64, error) {
+	U, sigma, VT, err := SVDDecomposition(A)
+	if err != nil {
+		return nil, err
+	}
+	m, n := len(A), len(A[0])
+	if tol <= 0 {
+		tol = DefaultTolerance(m, n, sigma)
+	}
+
+	pinv := make([][]float64, n)
+	for i := range pinv {
+		pinv[i] = make([]float64, m)
+	}
+	for k, s := range sigma {
+		if s <= tol {
+			continue
+		}
+		for i := 0; i < n; i++ {
+			vk := VT[k][i] / s
+			for j := 0; j < m; j++ {
+				pinv[i][j] += vk * U[j][k]
+			}
+		}
+	}
+	return pinv, nil
+}
+
+// SolveLeastSquares находит решение минимальной нормы задачи min ‖Ax − b‖₂.
+func SolveLeastSquares(A [][]float64, b []float64, tol float64) ([]float64, error) {
+	if len(A) != len(b) {
+		return nil, fmt.Errorf("несовместимые размеры матрицы и вектора")
+	}
+	pinv, err := PseudoInverse(A, tol)
+	if err != nil {
+		return nil, err
+	}
+	x := make([]float64, len(pinv))
+	for i := range pinv {
+		for j := range b {
+			x[i] += pinv[i][j] * b[j]
+		}
+	}
+	return x, nil
+}
+
+// LowRankApproximation возвращает наилучшее в 2-норме приближение матрицы A
+// ранга не выше k (теорема Эккарта–Янга) и погрешность σ_{k+1}.
+func LowRankApproximation(A [][]float64, k int) ([][]float64, float64, error) {
+	if k < 0 {
+		return nil, 0, fmt.Errorf("ранг должен быть неотрицательным")
+	}
+	U, sigma, VT, err := SVDDecomposition(A)
+	if err != nil {
+		return nil, 0, err
+	}
+	m, n := len(A), len(A[0])
+	k = min(k, len(sigma))
+
+	Ak := make([][]float64, m)
+	for i := range Ak {
+		Ak[i] = make([]float64, n)
+	}
+	for r := 0; r < k; r++ {
+		for i := 0; i < m; i++ {
+			us := U[i][r] * sigma[r]
+			for j := 0; j < n; j++ {
+				Ak[i][j] += us * VT[r][j]
+			}
+		}
+	}
+
+	residual := 0.0
+	if k < len(sigma) {
+		residual = sigma[k]
+	}
+	return Ak, residual, nil
+}
+
+// A = U·Σ·Vᵀ
+func VerifyDecomposition(A, U [][]float64, sigma []float64, VT [][]float64) error {
+	m := len(A)
+	if m == 0 || len(U) != m || len(VT) != len(sigma) {
+		return fmt.Errorf("несовместимые размеры разложения")
+	}
+	n := len(A[0])
+
+	const tolerance = 1e-8
+	scale := 1.0
+	if len(sigma) > 0 {
+		scale = math.Max(1, sigma[0])
+	}
+	for i := 0; i < m; i++ {
+		for j := 0; j < n; j++ {
+			sum := 0.0
+			for k := range sigma {
+				sum += U[i][k] * sigma[k] * VT[k][j]
+			}
+			if math.Abs(sum-A[i][j]) > tolerance*scale {
+				return fmt.Errorf("разложение не восстанавливает матрицу: элемент (%d, %d), ожидалось %.8f, получилось %.8f",
+					i, j, A[i][j], sum)
+			}
+		}
+	}
+	return nil
+}
+
+func transpose(A [][]float64) [][]float64 {
+	if len(A) == 0 {
+		return nil
+	}
+	T := make([][]float64, len(A[0]))
+	for j := range T {
+		T[j] = make([]float64, len(A))
+		for i := range A {
+			T[j][i] = A[i][j]
+		}
+	}
+	return T
+}
+
+func identity(n int) [][]float64 {
+	I := make([][]float64, n)
+	for i := range I {
+		I[i] = make([]float64, n)
+		I[i][i] = 1
+	}
+	return I
+}
@@ -0,0 +1,132 @@
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/KaiserRed/numeric_methods/internal/eigen"
+)
+
+type Matrix [][]float64
+
+func main() {
+	A, B, epsilon, err := readInput("input.txt")
+	if err != nil {
+		fmt.Printf("Ошибка чтения: %v\n", err)
+		return
+	}
+
+	eigenvalues, eigenvectors, iterErrors, iterations, err := eigen.GeneralizedJacobi(A, B, epsilon)
+	if err != nil {
+		fmt.Printf("Ошибка: %v\n", err)
+		return
+	}
+
+	if err := writeResults("output.txt", A, B, eigenvalues, eigenvectors, iterErrors, iterations, epsilon); err != nil {
+		fmt.Printf("Ошибка записи: %v\n", err)
+		return
+	}
+
+	fmt.Println("Вычисления успешно завершены. Результаты в output.txt")
+}
+
+// Формат файла: n строк матрицы A, n строк матрицы B, точность.
+func readInput(filename string) (A, B Matrix, epsilon float64, err error) {
+	file, err := os.Open(filename)
+	if err != nil {
+		return nil, nil, 0, err
+	}
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+	lines := make([]string, 0)
+
+	for scanner.Scan() {
+		line := scanner.Text()
+		if strings.TrimSpace(line) != "" {
+			lines = append(lines, line)
+		}
+	}
+
+	if len(lines) < 3 || (len(lines)-1)%2 != 0 {
+		return nil, nil, 0, fmt.Errorf("файл должен содержать матрицы A и B одного размера и строку с точностью")
+	}
+
+	n := (len(lines) - 1) / 2
+	rows := make(Matrix, 2*n)
+	for i := 0; i < 2*n; i++ {
+		parts := strings.Fields(lines[i])
+		if len(parts) != n {
+			return nil, nil, 0, fmt.Errorf("строка %d: ожидалось %d элементов, получено %d", i+1, n, len(parts))
+		}
+		rows[i] = make([]float64, n)
+		for j, p := range parts {
+			val, err := strconv.ParseFloat(p, 64)
+			if err != nil {
+				return nil, nil, 0, fmt.Errorf("ошибка в строке %d матрицы: %v", i+1, err)
+			}
+			rows[i][j] = val
+		}
+	}
+
+	epsilon, err = strconv.ParseFloat(strings.TrimSpace(lines[len(lines)-1]), 64)
+	if err != nil {
+		return nil, nil, 0, fmt.Errorf("ошибка чтения точности: %v", err)
+	}
+
+	return rows[:n], rows[n:], epsilon, nil
+}
+
+func writeMatrix(writer *bufio.Writer, title string, M Matrix) {
+	writer.WriteString(title + ":\n")
+	for _, row := range M {
+		for _, val := range row {
+			writer.WriteString(fmt.Sprintf("%10.6f ", val))
+		}
+		writer.WriteString("\n")
+	}
+}
+
+func writeResults(filename string, A, B Matrix, eigenvalues []float64, eigenvectors Matrix, iterErrors []float64, iterations int, epsilon float64) error {
+	file, err := os.Create(filename)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	writer := bufio.NewWriter(file)
+
+	writer.WriteString("Обобщённая задача A*x = λ*B*x\n\n")
+	writeMatrix(writer, "Матрица A", A)
+	writer.WriteString("\n")
+	writeMatrix(writer, "Матрица B", B)
+
+	writer.WriteString(fmt.Sprintf("\nТочность вычислений: %.0e\n", epsilon))
+
+	writer.WriteString("\nСобственные значения:\n")
+	for i, val := range eigenvalues {
+		writer.WriteString(fmt.Sprintf("λ%d = %.6f\n", i+1, val))
+	}
+
+	writer.WriteString("\n")
+	writeMatrix(writer, "B-ортонормированные собственные векторы (по столбцам)", eigenvectors)
+
+	residuals, defect := eigen.VerifyGeneralized(A, B, eigenvalues, eigenvectors)
+	writer.WriteString("\nПроверка точности (A*x - λ*B*x):\n")
+	for i, err := range residuals {
+		writer.WriteString(fmt.Sprintf("Для λ%d: %.3e\n", i+1, err))
+	}
+	writer.WriteString(fmt.Sprintf("\nB-ортонормированность ‖XᵀBX - I‖: %.3e\n", defect))
+
+	writer.WriteString("\nЗависимость погрешности от итераций:\n")
+	for i, err := range iterErrors {
+		writer.WriteString(fmt.Sprintf("%4d: %.3e\n", i, err))
+	}
+
+	writer.WriteString(fmt.Sprintf("\nВсего итераций: %d\n", iterations))
+
+	return writer.Flush()
+}
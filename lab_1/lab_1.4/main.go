@@ -3,10 +3,12 @@ package main
 import (
 	"bufio"
 	"fmt"
-	"math"
 	"os"
 	"strconv"
 	"strings"
+
+	"github.com/KaiserRed/numeric_methods/internal/eigen"
+	"github.com/KaiserRed/numeric_methods/internal/spectrum"
 )
 
 type Matrix [][]float64
@@ -18,14 +20,29 @@ func main() {
 		return
 	}
 
-	if !isSymmetric(A) {
+	if !eigen.IsSymmetric(A) {
 		fmt.Println("Ошибка: матрица не симметрическая!")
 		return
 	}
 
-	eigenvalues, eigenvectors, iterErrors, iterations := jacobiRotation(A, epsilon)
+	intervals, err := spectrum.SymmetricIntervals(A, epsilon)
+	if err != nil {
+		fmt.Printf("Ошибка локализации спектра: %v\n", err)
+		return
+	}
+
+	result := eigen.Jacobi(A, epsilon, eigen.Ascending)
 
-	if err := writeResults("output.txt", A, eigenvalues, eigenvectors, iterErrors, iterations, epsilon); err != nil {
+	eigenvalues := make([]complex128, len(result.Values))
+	for i, val := range result.Values {
+		eigenvalues[i] = complex(val, 0)
+	}
+	if err := spectrum.WriteSVG("spectrum.svg", spectrum.GershgorinRows(A), spectrum.GershgorinColumns(A), eigenvalues); err != nil {
+		fmt.Printf("Ошибка записи SVG: %v\n", err)
+		return
+	}
+
+	if err := writeResults("output.txt", A, result, intervals, epsilon); err != nil {
 		fmt.Printf("Ошибка записи: %v\n", err)
 		return
 	}
@@ -82,129 +99,7 @@ func readInput(filename string) (A [][]float64, epsilon float64, err error) {
 	return A, epsilon, nil
 }
 
-func isSymmetric(A Matrix) bool {
-	n := len(A)
-	for i := 0; i < n; i++ {
-		for j := 0; j < n; j++ {
-			if math.Abs(A[i][j]-A[j][i]) > 1e-6 {
-				return false
-			}
-		}
-	}
-	return true
-}
-
-func jacobiRotation(A Matrix, epsilon float64) ([]float64, Matrix, []float64, int) {
-	n := len(A)
-	V := make(Matrix, n)
-	for i := range V {
-		V[i] = make([]float64, n)
-		V[i][i] = 1.0
-	}
-
-	iterations := 0
-	maxOffDiag := maxOffDiagonal(A)
-	errors := []float64{maxOffDiag}
-
-	for maxOffDiag > epsilon && iterations < 1000 {
-		p, q := findMaxOffDiagonal(A)
-		phi := 0.5 * math.Atan2(2*A[p][q], A[q][q]-A[p][p])
-		c := math.Cos(phi)
-		s := math.Sin(phi)
-
-		newA := make(Matrix, n)
-		for i := range newA {
-			newA[i] = make([]float64, n)
-			copy(newA[i], A[i])
-		}
-
-		for i := 0; i < n; i++ {
-			if i != p && i != q {
-				newA[i][p] = c*A[i][p] - s*A[i][q]
-				newA[p][i] = newA[i][p]
-				newA[i][q] = s*A[i][p] + c*A[i][q]
-				newA[q][i] = newA[i][q]
-			}
-		}
-
-		newA[p][p] = c*c*A[p][p] - 2*c*s*A[p][q] + s*s*A[q][q]
-		newA[q][q] = s*s*A[p][p] + 2*c*s*A[p][q] + c*c*A[q][q]
-		newA[p][q] = 0
-		newA[q][p] = 0
-
-		A = newA
-
-		for i := 0; i < n; i++ {
-			vip := V[i][p]
-			viq := V[i][q]
-			V[i][p] = c*vip - s*viq
-			V[i][q] = s*vip + c*viq
-		}
-
-		maxOffDiag = maxOffDiagonal(A)
-		errors = append(errors, maxOffDiag)
-		iterations++
-	}
-
-	eigenvalues := make([]float64, n)
-	for i := 0; i < n; i++ {
-		eigenvalues[i] = A[i][i]
-	}
-
-	return eigenvalues, V, errors, iterations
-}
-
-func maxOffDiagonal(A Matrix) float64 {
-	max := 0.0
-	n := len(A)
-	for i := 0; i < n; i++ {
-		for j := i + 1; j < n; j++ {
-			if val := math.Abs(A[i][j]); val > max {
-				max = val
-			}
-		}
-	}
-	return max
-}
-
-func findMaxOffDiagonal(A Matrix) (int, int) {
-	max := 0.0
-	p, q := 0, 0
-	n := len(A)
-	for i := 0; i < n; i++ {
-		for j := i + 1; j < n; j++ {
-			if val := math.Abs(A[i][j]); val > max {
-				max = val
-				p, q = i, j
-			}
-		}
-	}
-	return p, q
-}
-
-func verifyEigen(A Matrix, eigenvalues []float64, eigenvectors Matrix) []float64 {
-	n := len(A)
-	errors := make([]float64, n)
-
-	for k := 0; k < n; k++ {
-		maxError := 0.0
-		for i := 0; i < n; i++ {
-			sum := 0.0
-			for j := 0; j < n; j++ {
-				sum += A[i][j] * eigenvectors[j][k]
-			}
-			error := math.Abs(sum - eigenvalues[k]*eigenvectors[i][k])
-			if error > maxError {
-				maxError = error
-			}
-		}
-		errors[k] = maxError
-	}
-
-	return errors
-}
-
-func writeResults(filename string, A Matrix, eigenvalues []float64, eigenvectors Matrix, iterErrors []float64, iterations int, epsilon float64) error {
+func writeResults(filename string, A Matrix, result eigen.EigenResult, intervals []spectrum.Interval, epsilon float64) error {
 	file, err := os.Create(filename)
 	if err != nil {
 		return err
@@ -223,12 +118,21 @@ func writeResults(filename string, A Matrix, eigenvalues []float64, eigenvectors
 
 	writer.WriteString(fmt.Sprintf("\nТочность вычислений: %.0e\n", epsilon))
 
-	writer.WriteString("\nСобственные значения:\n")
+	lo, hi := spectrum.RealBounds(A)
+	writer.WriteString(fmt.Sprintf("\nОценка Гершгорина: спектр лежит в [%.6f, %.6f]\n", lo, hi))
+	writer.WriteString("Интервалы бисекции Штурма:\n")
+	for i, iv := range intervals {
+		writer.WriteString(fmt.Sprintf("λ%d ∈ [%.8f, %.8f]\n", i+1, iv.Lo, iv.Hi))
+	}
+
+	eigenvalues, eigenvectors := result.Values, result.Vectors
+
+	writer.WriteString("\nСобственные значения (по возрастанию):\n")
 	for i, val := range eigenvalues {
 		writer.WriteString(fmt.Sprintf("λ%d = %.6f\n", i+1, val))
 	}
 
-	writer.WriteString("\nСобственные векторы (по столбцам):\n")
+	writer.WriteString("\nСобственные векторы (по столбцам, нормированы, наибольшая компонента положительна):\n")
 	for i := 0; i < len(eigenvectors); i++ {
 		for j := 0; j < len(eigenvectors); j++ {
 			writer.WriteString(fmt.Sprintf("%10.6f ", eigenvectors[i][j]))
@@ -236,18 +140,30 @@ func writeResults(filename string, A Matrix, eigenvalues []float64, eigenvectors
 		writer.WriteString("\n")
 	}
 
-	verificationErrors := verifyEigen(A, eigenvalues, eigenvectors)
+	verificationErrors := eigen.VerifyEigen(A, eigenvalues, eigenvectors)
 	writer.WriteString("\nПроверка точности (A*v - λ*v):\n")
 	for i, err := range verificationErrors {
 		writer.WriteString(fmt.Sprintf("Для λ%d: %.3e\n", i+1, err))
 	}
 
+	writer.WriteString("\nПроверка по интервалам Штурма:\n")
+	for i, val := range eigenvalues {
+		status := "внутри интервала"
+		if i >= len(intervals) || !intervals[i].Contains(val, epsilon) {
+			status = "ВНЕ интервала"
+		}
+		writer.WriteString(fmt.Sprintf("λ%d = %.6f: %s\n", i+1, val, status))
+	}
+
+	writer.WriteString(fmt.Sprintf("\nОртогональность ‖VᵀV - I‖: %.3e\n", result.OrthogonalityDefect()))
+	writer.WriteString(fmt.Sprintf("Восстановление ‖A - VΛVᵀ‖: %.3e\n", result.ReconstructionError(A)))
+
 	writer.WriteString("\nЗависимость погрешности от итераций:\n")
-	for i, err := range iterErrors {
+	for i, err := range result.IterErrors {
 		writer.WriteString(fmt.Sprintf("%4d: %.3e\n", i, err))
 	}
 
-	writer.WriteString(fmt.Sprintf("\nВсего итераций: %d\n", iterations))
+	writer.WriteString(fmt.Sprintf("\nВсего итераций: %d\n", result.Iterations))
 
 	return writer.Flush()
 }
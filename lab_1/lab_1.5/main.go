@@ -3,12 +3,14 @@ package main
 import (
 	"bufio"
 	"fmt"
-	"math"
 	"math/cmplx"
 	"math/rand"
 	"os"
 	"strconv"
 	"strings"
+
+	"github.com/KaiserRed/numeric_methods/internal/eigen"
+	"github.com/KaiserRed/numeric_methods/internal/spectrum"
 )
 
 type Matrix [][]float64
@@ -25,10 +27,21 @@ func main() {
 		return
 	}
 
-	eigenvalues, iterations := qrAlgorithm(A, epsilon)
+	eigenvalues, iterations, err := eigen.Eigenvalues(A)
+	if err != nil {
+		fmt.Printf("Ошибка: %v\n", err)
+		return
+	}
 
 	verificationErrors := verifyEigenvalues(A, eigenvalues)
 
+	rows := spectrum.GershgorinRows(A)
+	cols := spectrum.GershgorinColumns(A)
+	if err := spectrum.WriteSVG("spectrum.svg", rows, cols, eigenvalues); err != nil {
+		fmt.Printf("Ошибка записи SVG: %v\n", err)
+		return
+	}
+
 	if err := writeResults("output.txt", A, eigenvalues, iterations, epsilon, verificationErrors); err != nil {
 		fmt.Printf("Ошибка записи: %v\n", err)
 		return
@@ -82,121 +95,6 @@ func readInput(filename string) (Matrix, float64, error) {
 	return matrix, epsilon, nil
 }
 
-func qrAlgorithm(A Matrix, epsilon float64) ([]complex128, int) {
-	//n := len(A)
-	currentA := copyMatrix(A)
-	iterations := 0
-
-	for {
-		Q, R := qrDecomposition(currentA)
-
-		// Обновление матрицы: A = R*Q
-		currentA = multiplyMatrices(R, Q)
-		iterations++
-
-		if isUpperTriangular(currentA, epsilon) {
-			break
-		}
-
-		if iterations > 1000 {
-			break
-		}
-	}
-
-	eigenvalues := extractEigenvalues(currentA, epsilon)
-	return eigenvalues, iterations
-}
-
-func qrDecomposition(A Matrix) (Q, R Matrix) {
-	n := len(A)
-	Q = make(Matrix, n)
-	R = make(Matrix, n)
-	for i := range Q {
-		Q[i] = make([]float64, n)
-		R[i] = make([]float64, n)
-	}
-
-	for j := 0; j < n; j++ {
-		v := make([]float64, n)
-		for i := 0; i < n; i++ {
-			v[i] = A[i][j]
-		}
-
-		for k := 0; k < j; k++ {
-			dot := 0.0
-			for i := 0; i < n; i++ {
-				dot += v[i] * Q[i][k]
-			}
-			R[k][j] = dot
-
-			for i := 0; i < n; i++ {
-				v[i] -= dot * Q[i][k]
-			}
-		}
-
-		norm := 0.0
-		for i := 0; i < n; i++ {
-			norm += v[i] * v[i]
-		}
-		norm = math.Sqrt(norm)
-
-		for i := 0; i < n; i++ {
-			Q[i][j] = v[i] / norm
-		}
-		R[j][j] = norm
-	}
-
-	return Q, R
-}
-
-func isUpperTriangular(A Matrix, epsilon float64) bool {
-	n := len(A)
-	for i := 1; i < n; i++ {
-		for j := 0; j < i; j++ {
-			if math.Abs(A[i][j]) > epsilon {
-				return false
-			}
-		}
-	}
-	return true
-}
-
-func extractEigenvalues(A Matrix, epsilon float64) []complex128 {
-	n := len(A)
-	eigenvalues := make([]complex128, n)
-
-	for i := 0; i < n; {
-		if i < n-1 && math.Abs(A[i+1][i]) > epsilon {
-			// Обработка блока 2x2 для комплексных собственных значений
-			a := A[i][i]
-			b := A[i][i+1]
-			c := A[i+1][i]
-			d := A[i+1][i+1]
-
-			trace := a + d
-			det := a*d - b*c
-			discriminant := trace*trace - 4*det
-
-			if discriminant < 0 {
-				realPart := trace / 2
-				imagPart := math.Sqrt(-discriminant) / 2
-				eigenvalues[i] = complex(realPart, imagPart)
-				eigenvalues[i+1] = complex(realPart, -imagPart)
-				i += 2
-			} else {
-				eigenvalues[i] = complex((trace+math.Sqrt(discriminant))/2, 0)
-				eigenvalues[i+1] = complex((trace-math.Sqrt(discriminant))/2, 0)
-				i += 2
-			}
-		} else {
-			eigenvalues[i] = complex(A[i][i], 0)
-			i++
-		}
-	}
-
-	return eigenvalues
-}
-
 func verifyEigenvalues(A Matrix, eigenvalues []complex128) []float64 {
 	n := len(A)
 	errors := make([]float64, n)
@@ -235,32 +133,6 @@ func verifyEigenvalues(A Matrix, eigenvalues []complex128) []float64 {
 	return errors
 }
 
-func multiplyMatrices(A, B Matrix) Matrix {
-	n := len(A)
-	result := make(Matrix, n)
-	for i := range result {
-		result[i] = make([]float64, n)
-		for j := 0; j < n; j++ {
-			for k := 0; k < n; k++ {
-				result[i][j] += A[i][k] * B[k][j]
-			}
-		}
-	}
-	return result
-}
-
-func copyMatrix(A Matrix) Matrix {
-	n := len(A)
-	copy := make(Matrix, n)
-	for i := range copy {
-		copy[i] = make([]float64, n)
-		for j := 0; j < n; j++ {
-			copy[i][j] = A[i][j]
-		}
-	}
-	return copy
-}
-
 func writeResults(filename string, A Matrix, eigenvalues []complex128, iterations int, epsilon float64, errors []float64) error {
 	file, err := os.Create(filename)
 	if err != nil {
@@ -291,5 +163,23 @@ func writeResults(filename string, A Matrix, eigenvalues []complex128, iteration
 		writer.WriteString(fmt.Sprintf(" (ошибка: %.3e)\n", errors[i]))
 	}
 
+	writer.WriteString("\nКруги Гершгорина (по строкам):\n")
+	for i, d := range spectrum.GershgorinRows(A) {
+		writer.WriteString(fmt.Sprintf("|z - %.6f| <= %.6f (строка %d)\n", d.Center, d.Radius, i+1))
+	}
+	writer.WriteString("Круги Гершгорина (по столбцам):\n")
+	for i, d := range spectrum.GershgorinColumns(A) {
+		writer.WriteString(fmt.Sprintf("|z - %.6f| <= %.6f (столбец %d)\n", d.Center, d.Radius, i+1))
+	}
+
+	writer.WriteString("\nПроверка попадания в круги Гершгорина:\n")
+	for i, ok := range spectrum.CheckEigenvalues(A, eigenvalues, epsilon) {
+		status := "внутри"
+		if !ok {
+			status = "ВНЕ кругов"
+		}
+		writer.WriteString(fmt.Sprintf("λ%d: %s\n", i+1, status))
+	}
+
 	return writer.Flush()
 }
@@ -0,0 +1,186 @@
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"math"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/KaiserRed/numeric_methods/internal/eigen"
+	"github.com/KaiserRed/numeric_methods/internal/matrix_func"
+)
+
+type Matrix [][]float64
+
+func main() {
+	A, epsilon, t, err := readInput("input.txt")
+	if err != nil {
+		fmt.Printf("Ошибка чтения: %v\n", err)
+		return
+	}
+
+	if err := writeResults("output.txt", A, epsilon, t); err != nil {
+		fmt.Printf("Ошибка записи: %v\n", err)
+		return
+	}
+
+	fmt.Println("Вычисления успешно завершены. Результаты в output.txt")
+}
+
+// Формат файла: строки матрицы, затем строка "epsilon [t]" (по умолчанию t = 1).
+func readInput(filename string) (A Matrix, epsilon, t float64, err error) {
+	file, err := os.Open(filename)
+	if err != nil {
+		return nil, 0, 0, err
+	}
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+	lines := make([]string, 0)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if strings.TrimSpace(line) != "" {
+			lines = append(lines, line)
+		}
+	}
+
+	if len(lines) < 2 {
+		return nil, 0, 0, fmt.Errorf("файл должен содержать минимум 2 строки (матрица и точность)")
+	}
+
+	A = make(Matrix, len(lines)-1)
+	for i := 0; i < len(lines)-1; i++ {
+		parts := strings.Fields(lines[i])
+		A[i] = make([]float64, len(parts))
+		for j, p := range parts {
+			val, err := strconv.ParseFloat(p, 64)
+			if err != nil {
+				return nil, 0, 0, fmt.Errorf("ошибка в строке %d матрицы: %v", i+1, err)
+			}
+			A[i][j] = val
+		}
+	}
+	for _, row := range A {
+		if len(row) != len(A) {
+			return nil, 0, 0, fmt.Errorf("матрица должна быть квадратной")
+		}
+	}
+
+	parts := strings.Fields(lines[len(lines)-1])
+	epsilon, err = strconv.ParseFloat(parts[0], 64)
+	if err != nil {
+		return nil, 0, 0, fmt.Errorf("ошибка чтения точности: %v", err)
+	}
+	t = 1
+	if len(parts) > 1 {
+		t, err = strconv.ParseFloat(parts[1], 64)
+		if err != nil {
+			return nil, 0, 0, fmt.Errorf("ошибка чтения t: %v", err)
+		}
+	}
+
+	return A, epsilon, t, nil
+}
+
+func writeMatrix(writer *bufio.Writer, title string, M Matrix) {
+	writer.WriteString(title + ":\n")
+	for _, row := range M {
+		for _, val := range row {
+			writer.WriteString(fmt.Sprintf("%12.6f ", val))
+		}
+		writer.WriteString("\n")
+	}
+}
+
+func maxDiff(A, B Matrix) float64 {
+	max := 0.0
+	for i := range A {
+		for j := range A[i] {
+			max = math.Max(max, math.Abs(A[i][j]-B[i][j]))
+		}
+	}
+	return max
+}
+
+func multiply(A, B Matrix) Matrix {
+	n := len(A)
+	C := make(Matrix, n)
+	for i := range C {
+		C[i] = make([]float64, n)
+		for k := 0; k < n; k++ {
+			for j := 0; j < n; j++ {
+				C[i][j] += A[i][k] * B[k][j]
+			}
+		}
+	}
+	return C
+}
+
+func writeEigenCheck(writer *bufio.Writer, A, F Matrix, f func(float64) float64, epsilon float64) {
+	if !eigen.IsSymmetric(A) {
+		return
+	}
+	d, err := matrix_func.EigenDiscrepancy(A, F, f, epsilon)
+	if err != nil {
+		writer.WriteString(fmt.Sprintf("Сравнение с собственным разложением: %v\n", err))
+		return
+	}
+	writer.WriteString(fmt.Sprintf("Сравнение с собственным разложением: %.3e\n", d))
+}
+
+func writeResults(filename string, A Matrix, epsilon, t float64) error {
+	file, err := os.Create(filename)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	writer := bufio.NewWriter(file)
+
+	writeMatrix(writer, "Исходная матрица A", A)
+	writer.WriteString(fmt.Sprintf("\nТочность вычислений: %.0e\n", epsilon))
+	writer.WriteString(fmt.Sprintf("Параметр t = %g\n", t))
+
+	tA := make(Matrix, len(A))
+	for i := range A {
+		tA[i] = make([]float64, len(A))
+		for j := range A[i] {
+			tA[i][j] = t * A[i][j]
+		}
+	}
+
+	writer.WriteString("\n")
+	E, err := matrix_func.Exp(tA)
+	if err != nil {
+		writer.WriteString(fmt.Sprintf("Экспонента: %v\n", err))
+	} else {
+		writeMatrix(writer, "exp(tA) (Паде [13/13] с масштабированием)", E)
+		writeEigenCheck(writer, tA, E, math.Exp, epsilon)
+	}
+
+	writer.WriteString("\n")
+	S, iterations, err := matrix_func.Sqrt(A, epsilon)
+	if err != nil {
+		writer.WriteString(fmt.Sprintf("Квадратный корень: %v\n", err))
+	} else {
+		writeMatrix(writer, fmt.Sprintf("sqrt(A) (Денман–Биверс, итераций: %d)", iterations), S)
+		writer.WriteString(fmt.Sprintf("Невязка max|S² - A|: %.3e\n", maxDiff(multiply(S, S), A)))
+		writeEigenCheck(writer, A, S, math.Sqrt, epsilon)
+	}
+
+	writer.WriteString("\n")
+	L, err := matrix_func.Log(A, epsilon)
+	if err != nil {
+		writer.WriteString(fmt.Sprintf("Логарифм: %v\n", err))
+	} else {
+		writeMatrix(writer, "log(A) (обратное масштабирование и возведение в квадрат)", L)
+		if expL, err := matrix_func.Exp(L); err == nil {
+			writer.WriteString(fmt.Sprintf("Невязка max|exp(log A) - A|: %.3e\n", maxDiff(expL, A)))
+		}
+		writeEigenCheck(writer, A, L, math.Log, epsilon)
+	}
+
+	return writer.Flush()
+}
@@ -0,0 +1,264 @@
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/KaiserRed/numeric_methods/internal/expr"
+	"github.com/KaiserRed/numeric_methods/internal/nonlinear"
+)
+
+// Система по умолчанию — та же, что в lab_2.2
+const (
+	defaultF1 = "a*x^2 - x + y^2 - 1"
+	defaultF2 = "y - tan(x)"
+)
+
+// Config — система, продолжаемый параметр и настройки продолжения
+type Config struct {
+	f1, f2    string
+	parameter string
+	constants map[string]float64
+	start     []float64
+	methods   []nonlinear.ContinuationMethod
+	params    nonlinear.ContinuationParams
+}
+
+var methodNames = map[string][]nonlinear.ContinuationMethod{
+	"natural":   {nonlinear.NaturalParameter},
+	"arclength": {nonlinear.PseudoArclength},
+	"both":      {nonlinear.NaturalParameter, nonlinear.PseudoArclength},
+}
+
+var csvNames = map[nonlinear.ContinuationMethod]string{
+	nonlinear.NaturalParameter: "branch_natural.csv",
+	nonlinear.PseudoArclength:  "branch_arclength.csv",
+}
+
+func main() {
+	cfg, err := readInput("input.txt")
+	if err != nil {
+		fmt.Printf("Ошибка чтения: %v\n", err)
+		return
+	}
+
+	ps, err := buildSystem(cfg)
+	if err != nil {
+		fmt.Printf("Ошибка разбора системы: %v\n", err)
+		return
+	}
+
+	branches := make([]nonlinear.Branch, len(cfg.methods))
+	errs := make([]error, len(cfg.methods))
+	for i, method := range cfg.methods {
+		params := cfg.params
+		params.Method = method
+		branches[i], errs[i] = nonlinear.Continue(ps, cfg.start, params)
+		if err := nonlinear.WriteBranchCSV(csvNames[method], branches[i]); err != nil {
+			fmt.Printf("Ошибка записи CSV: %v\n", err)
+			return
+		}
+	}
+
+	if err := writeResults("output.txt", cfg, branches, errs); err != nil {
+		fmt.Printf("Ошибка записи: %v\n", err)
+		return
+	}
+
+	fmt.Println("Вычисления завершены. Результаты в output.txt, ветви решений в CSV-файлах")
+}
+
+// Формат файла: строки key=value; f1=, f2= (по умолчанию система lab_2.2),
+// param <имя>=<значение>, parameter=a (продолжаемый параметр), from=, to=,
+// x0=, y0=, step=, min_step=, max_step=, max_steps=, epsilon=, maxIter=,
+// method=natural|arclength|both.
+func readInput(filename string) (Config, error) {
+	file, err := os.Open(filename)
+	if err != nil {
+		return Config{}, err
+	}
+	defer file.Close()
+
+	cfg := Config{
+		f1: defaultF1, f2: defaultF2,
+		parameter: "a",
+		constants: map[string]float64{},
+		start:     []float64{0.7, 0.85},
+		methods:   methodNames["both"],
+		params: nonlinear.ContinuationParams{
+			From: 2, To: -2,
+			Step: 0.05, MinStep: 1e-6, MaxStep: 0.2,
+			MaxSteps: 1000,
+			Newton:   nonlinear.Params{AbsTol: 1e-10, MaxIter: 30},
+		},
+	}
+	floats := map[string]*float64{
+		"from": &cfg.params.From, "to": &cfg.params.To,
+		"x0": &cfg.start[0], "y0": &cfg.start[1],
+		"step": &cfg.params.Step, "min_step": &cfg.params.MinStep, "max_step": &cfg.params.MaxStep,
+		"epsilon": &cfg.params.Newton.AbsTol,
+	}
+	ints := map[string]*int{"max_steps": &cfg.params.MaxSteps, "maxIter": &cfg.params.Newton.MaxIter}
+
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		if strings.HasPrefix(line, "param ") {
+			name, value, ok := strings.Cut(strings.TrimPrefix(line, "param "), "=")
+			if !ok {
+				return Config{}, fmt.Errorf("ожидалось: param <имя>=<значение>")
+			}
+			val, err := strconv.ParseFloat(strings.TrimSpace(value), 64)
+			if err != nil {
+				return Config{}, fmt.Errorf("ошибка чтения параметра %s: %v", name, err)
+			}
+			cfg.constants[strings.TrimSpace(name)] = val
+			continue
+		}
+
+		key, value, ok := strings.Cut(line, "=")
+		if !ok {
+			return Config{}, fmt.Errorf("ожидалось: <ключ>=<значение>, получено %q", line)
+		}
+		key, value = strings.TrimSpace(key), strings.TrimSpace(value)
+		switch {
+		case floats[key] != nil:
+			val, err := strconv.ParseFloat(value, 64)
+			if err != nil {
+				return Config{}, fmt.Errorf("ошибка чтения %s: %v", key, err)
+			}
+			*floats[key] = val
+		case ints[key] != nil:
+			val, err := strconv.Atoi(value)
+			if err != nil {
+				return Config{}, fmt.Errorf("ошибка чтения %s: %v", key, err)
+			}
+			*ints[key] = val
+		case key == "f1":
+			cfg.f1 = value
+		case key == "f2":
+			cfg.f2 = value
+		case key == "parameter":
+			cfg.parameter = value
+		case key == "method":
+			methods, ok := methodNames[value]
+			if !ok {
+				return Config{}, fmt.Errorf("неизвестный метод %q (допустимы natural, arclength, both)", value)
+			}
+			cfg.methods = methods
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return Config{}, err
+	}
+
+	// Продолжаемый параметр — переменная, а не константа
+	delete(cfg.constants, cfg.parameter)
+	return cfg, nil
+}
+
+// buildSystem компилирует F(x, y; λ), её матрицу Якоби по (x, y) и
+// производную по параметру.
+func buildSystem(cfg Config) (nonlinear.ParametricSystem, error) {
+	vars := []string{"x", "y", cfg.parameter}
+	var fs []expr.Node
+	for i, src := range []string{cfg.f1, cfg.f2} {
+		n, err := expr.Parse(src)
+		if err != nil {
+			return nonlinear.ParametricSystem{}, fmt.Errorf("функция f%d: %v", i+1, err)
+		}
+		fs = append(fs, n)
+	}
+	jac, err := expr.Jacobian(fs, vars)
+	if err != nil {
+		return nonlinear.ParametricSystem{}, fmt.Errorf("якобиан: %v", err)
+	}
+
+	F := make([]func([]float64) float64, 2)
+	J := make([][]func([]float64) float64, 2)
+	for i := range fs {
+		F[i], err = expr.Compile(fs[i], vars, cfg.constants)
+		if err != nil {
+			return nonlinear.ParametricSystem{}, fmt.Errorf("функция f%d: %v", i+1, err)
+		}
+		J[i] = make([]func([]float64) float64, 3)
+		for j := range jac[i] {
+			J[i][j], err = expr.Compile(jac[i][j], vars, cfg.constants)
+			if err != nil {
+				return nonlinear.ParametricSystem{}, fmt.Errorf("якобиан: %v", err)
+			}
+		}
+	}
+
+	args := func(x []float64, lambda float64) []float64 { return []float64{x[0], x[1], lambda} }
+	return nonlinear.ParametricSystem{
+		F: func(x []float64, lambda float64) []float64 {
+			v := args(x, lambda)
+			return []float64{F[0](v), F[1](v)}
+		},
+		Jx: func(x []float64, lambda float64) [][]float64 {
+			v := args(x, lambda)
+			return [][]float64{
+				{J[0][0](v), J[0][1](v)},
+				{J[1][0](v), J[1][1](v)},
+			}
+		},
+		Jl: func(x []float64, lambda float64) []float64 {
+			v := args(x, lambda)
+			return []float64{J[0][2](v), J[1][2](v)}
+		},
+	}, nil
+}
+
+func writeResults(filename string, cfg Config, branches []nonlinear.Branch, errs []error) error {
+	file, err := os.Create(filename)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	writer := bufio.NewWriter(file)
+	defer writer.Flush()
+
+	p := cfg.params
+	fmt.Fprintf(writer, "Система: f1(x, y) = %s = 0, f2(x, y) = %s = 0\n", cfg.f1, cfg.f2)
+	fmt.Fprintf(writer, "Продолжение по параметру %s от %g до %g\n", cfg.parameter, p.From, p.To)
+	fmt.Fprintf(writer, "Начальное приближение: x0 = %.6f, y0 = %.6f\n", cfg.start[0], cfg.start[1])
+	fmt.Fprintf(writer, "Шаг: начальный %g, минимальный %g, максимальный %g; макс. шагов: %d\n",
+		p.Step, p.MinStep, p.MaxStep, p.MaxSteps)
+	fmt.Fprintf(writer, "Корректор: метод Ньютона, точность %.0e, макс. итераций %d\n", p.Newton.AbsTol, p.Newton.MaxIter)
+
+	for i, method := range cfg.methods {
+		b := branches[i]
+		fmt.Fprintf(writer, "\n--- Продолжение %v (%s) ---\n", method, csvNames[method])
+		if errs[i] != nil {
+			fmt.Fprintf(writer, "Остановка: %v\n", errs[i])
+		}
+		fmt.Fprintf(writer, "Точек ветви: %d\n", len(b.Points))
+		if len(b.Points) == 0 {
+			continue
+		}
+		first, last := b.Points[0], b.Points[len(b.Points)-1]
+		fmt.Fprintf(writer, "Начало: %s = %.6f, x = %.6f, y = %.6f, det J = %.4e\n",
+			cfg.parameter, first.Lambda, first.X[0], first.X[1], first.DetJ)
+		fmt.Fprintf(writer, "Конец:  %s = %.6f, x = %.6f, y = %.6f, det J = %.4e\n",
+			cfg.parameter, last.Lambda, last.X[0], last.X[1], last.DetJ)
+
+		if len(b.Events) == 0 {
+			fmt.Fprintln(writer, "Смен знака det J не обнаружено")
+		}
+		for _, e := range b.Events {
+			pt := b.Points[e.Index]
+			fmt.Fprintf(writer, "%s: %s ≈ %.6f (между точками %d и %d, x ≈ %.6f, y ≈ %.6f)\n",
+				e.Kind, cfg.parameter, e.Lambda, e.Index, e.Index+1, pt.X[0], pt.X[1])
+		}
+	}
+
+	return nil
+}
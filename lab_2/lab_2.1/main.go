@@ -4,26 +4,79 @@ import (
 	"bufio"
 	"fmt"
 	"math"
+	"math/cmplx"
 	"os"
+	"sort"
 	"strconv"
 	"strings"
+
+	"github.com/KaiserRed/numeric_methods/internal/autodiff"
+	"github.com/KaiserRed/numeric_methods/internal/expr"
+	"github.com/KaiserRed/numeric_methods/internal/root_finding"
+)
+
+// Число точек выборки при оценке коэффициента сжатия φ
+const contractionSamples = 1001
+
+// Число подынтервалов начального разбиения при поиске всех корней
+const defaultScanIntervals = 100
+
+// Уравнение по умолчанию, если в файле не задана функция f
+const (
+	defaultF   = "ln(x+2) - x^4 + 0.5"
+	defaultDf  = "1/(x+2) - 4*x^3"
+	defaultPhi = "(ln(x+2) + 0.5)^0.25"
 )
 
-type Params struct {
-	a       float64
-	b       float64
-	epsilon float64
-	maxIter int
+type Input struct {
+	a      float64
+	b      float64
+	params root_finding.Params
+
+	fSrc, dfSrc, phiSrc string
+	constants           map[string]float64
+	dfSymbolic          bool
+	checkPoints         int
+	scanIntervals       int
+
+	// poly — коэффициенты многочлена по убыванию степеней
+	poly []float64
+
+	// z0 — начальное приближение для поиска комплексного корня f
+	z0      complex128
+	complex bool
 }
 
-func readInput(filename string) (Params, error) {
+type Equation struct {
+	f, df, phi func(float64) float64
+	d2f, dphi  func(float64) float64
+	fDual      func(autodiff.Dual) autodiff.Dual
+	fc, dfc    func(complex128) complex128
+	complexErr error
+	check      *expr.DerivativeCheck
+	checkErr   error
+
+	// lambda — параметр φ(x) = x − λ·f(x), если φ построена автоматически
+	lambda      float64
+	phiAuto     bool
+	contraction root_finding.Contraction
+	analysisErr error
+}
+
+type methodResult struct {
+	name   string
+	result root_finding.Result
+	err    error
+}
+
+func readInput(filename string) (Input, error) {
 	file, err := os.Open(filename)
 	if err != nil {
-		return Params{}, err
+		return Input{}, err
 	}
 	defer file.Close()
 
-	params := Params{}
+	input := Input{constants: map[string]float64{}, scanIntervals: defaultScanIntervals}
 	scanner := bufio.NewScanner(file)
 	for scanner.Scan() {
 		line := strings.TrimSpace(scanner.Text())
@@ -38,85 +91,332 @@ func readInput(filename string) (Params, error) {
 
 		key := strings.ToLower(parts[0])
 		value := parts[1]
+		rest := strings.TrimSpace(line[len(parts[0]):])
 
 		switch key {
+		case "f":
+			input.fSrc = rest
+		case "df":
+			input.dfSrc = rest
+		case "phi":
+			input.phiSrc = rest
+		case "check":
+			val, err := strconv.Atoi(value)
+			if err != nil || val < 1 {
+				return Input{}, fmt.Errorf("ошибка чтения check: ожидалось число точек проверки")
+			}
+			input.checkPoints = val
+		case "scan":
+			val, err := strconv.Atoi(value)
+			if err != nil || val < 1 {
+				return Input{}, fmt.Errorf("ошибка чтения scan: ожидалось число подынтервалов")
+			}
+			input.scanIntervals = val
+		case "poly":
+			input.poly = input.poly[:0]
+			for _, field := range parts[1:] {
+				val, err := strconv.ParseFloat(field, 64)
+				if err != nil {
+					return Input{}, fmt.Errorf("ошибка чтения коэффициентов многочлена: %v", err)
+				}
+				input.poly = append(input.poly, val)
+			}
+		case "z0":
+			if len(parts) != 3 {
+				return Input{}, fmt.Errorf("ожидалось: z0 <действительная часть> <мнимая часть>")
+			}
+			re, err1 := strconv.ParseFloat(parts[1], 64)
+			im, err2 := strconv.ParseFloat(parts[2], 64)
+			if err1 != nil || err2 != nil {
+				return Input{}, fmt.Errorf("ошибка чтения z0: ожидались два числа")
+			}
+			input.z0, input.complex = complex(re, im), true
+		case "param":
+			if len(parts) != 3 {
+				return Input{}, fmt.Errorf("ожидалось: param <имя> <значение>")
+			}
+			val, err := strconv.ParseFloat(parts[2], 64)
+			if err != nil {
+				return Input{}, fmt.Errorf("ошибка чтения параметра %s: %v", parts[1], err)
+			}
+			input.constants[parts[1]] = val
 		case "a":
 			val, err := strconv.ParseFloat(value, 64)
 			if err != nil {
-				return Params{}, fmt.Errorf("ошибка чтения a: %v", err)
+				return Input{}, fmt.Errorf("ошибка чтения a: %v", err)
 			}
-			params.a = val
+			input.a = val
 		case "b":
 			val, err := strconv.ParseFloat(value, 64)
 			if err != nil {
-				return Params{}, fmt.Errorf("ошибка чтения b: %v", err)
+				return Input{}, fmt.Errorf("ошибка чтения b: %v", err)
 			}
-			params.b = val
+			input.b = val
 		case "epsilon":
 			val, err := strconv.ParseFloat(value, 64)
 			if err != nil {
-				return Params{}, fmt.Errorf("ошибка чтения epsilon: %v", err)
+				return Input{}, fmt.Errorf("ошибка чтения epsilon: %v", err)
+			}
+			input.params.AbsTol = val
+		case "rel_tol":
+			val, err := strconv.ParseFloat(value, 64)
+			if err != nil {
+				return Input{}, fmt.Errorf("ошибка чтения rel_tol: %v", err)
 			}
-			params.epsilon = val
+			input.params.RelTol = val
+		case "f_tol":
+			val, err := strconv.ParseFloat(value, 64)
+			if err != nil {
+				return Input{}, fmt.Errorf("ошибка чтения f_tol: %v", err)
+			}
+			input.params.FTol = val
 		case "max_iter":
 			val, err := strconv.Atoi(value)
 			if err != nil {
-				return Params{}, fmt.Errorf("ошибка чтения max_iter: %v", err)
+				return Input{}, fmt.Errorf("ошибка чтения max_iter: %v", err)
 			}
-			params.maxIter = val
+			input.params.MaxIter = val
 		}
 	}
 
-	return params, nil
+	if input.fSrc == "" {
+		input.fSrc, input.dfSrc, input.phiSrc = defaultF, defaultDf, defaultPhi
+	}
+
+	return input, nil
 }
 
-func iterationMethod(phi func(float64) float64, params Params) (float64, []float64, int) {
-	x0 := (params.a + params.b) / 2
-	x := x0
-	errors := []float64{}
+// compileEquation компилирует f, df и phi. Если производная не задана,
+// она строится символьным дифференцированием f.
+func compileEquation(input *Input) (Equation, error) {
+	var eq Equation
+	fNode, err := expr.Parse(input.fSrc)
+	if err != nil {
+		return Equation{}, fmt.Errorf("функция f: %v", err)
+	}
+	eq.f, err = expr.Compile1(fNode, "x", input.constants)
+	if err != nil {
+		return Equation{}, fmt.Errorf("функция f: %v", err)
+	}
+
+	fDual, err := expr.CompileDual(fNode, []string{"x"}, input.constants)
+	if err != nil {
+		return Equation{}, fmt.Errorf("функция f: %v", err)
+	}
+	eq.fDual = func(x autodiff.Dual) autodiff.Dual { return fDual([]autodiff.Dual{x}) }
 
-	for i := 0; i < params.maxIter; i++ {
-		xNew := phi(x)
-		err := math.Abs(xNew - x)
-		errors = append(errors, err)
+	var dfNode expr.Node
+	if input.dfSrc != "" {
+		dfNode, err = expr.Parse(input.dfSrc)
+	} else {
+		dfNode, err = expr.Diff(fNode, "x")
+		if err == nil {
+			input.dfSrc = dfNode.String()
+			input.dfSymbolic = true
+		}
+	}
+	if err != nil {
+		return Equation{}, fmt.Errorf("функция df: %v", err)
+	}
+	eq.df, err = expr.Compile1(dfNode, "x", input.constants)
+	if err != nil {
+		return Equation{}, fmt.Errorf("функция df: %v", err)
+	}
+
+	// Вторая производная для методов Галлея и Ньютона для f/f'
+	if d2fNode, err := expr.Diff(dfNode, "x"); err == nil {
+		eq.d2f, _ = expr.Compile1(d2fNode, "x", input.constants)
+	}
+
+	if input.complex {
+		eq.fc, eq.complexErr = expr.CompileComplex1(fNode, "x", input.constants)
+		if eq.complexErr == nil {
+			eq.dfc, eq.complexErr = expr.CompileComplex1(dfNode, "x", input.constants)
+		}
+	}
+
+	if input.phiSrc != "" {
+		phiNode, err := expr.Parse(input.phiSrc)
+		if err != nil {
+			return Equation{}, fmt.Errorf("функция phi: %v", err)
+		}
+		eq.phi, err = expr.Compile1(phiNode, "x", input.constants)
+		if err != nil {
+			return Equation{}, fmt.Errorf("функция phi: %v", err)
+		}
+		if dphiNode, err := expr.Diff(phiNode, "x"); err == nil {
+			eq.dphi, _ = expr.Compile1(dphiNode, "x", input.constants)
+		}
+	} else {
+		eq.phi, eq.dphi, eq.lambda, err = root_finding.Relaxation(eq.f, eq.df, (input.a+input.b)/2)
+		if err != nil {
+			return Equation{}, fmt.Errorf("построение φ: %v", err)
+		}
+		eq.phiAuto = true
+	}
+	eq.contraction, eq.analysisErr = root_finding.AnalyzeContraction(eq.phi, eq.dphi, input.a, input.b, contractionSamples)
 
-		if err < params.epsilon {
-			return xNew, errors, i + 1
+	if input.checkPoints > 0 {
+		points := make([]float64, input.checkPoints)
+		for i := range points {
+			t := 0.5
+			if input.checkPoints > 1 {
+				t = float64(i) / float64(input.checkPoints-1)
+			}
+			points[i] = input.a + t*(input.b-input.a)
 		}
-		x = xNew
+		check, err := expr.CheckDerivative(fNode, dfNode, "x", input.constants, points)
+		eq.check, eq.checkErr = &check, err
 	}
+	return eq, nil
+}
 
-	return x, errors, params.maxIter
+func writeMethod(writer *bufio.Writer, m methodResult) {
+	fmt.Fprintf(writer, "\n%s:\n", m.name)
+	if m.err != nil {
+		fmt.Fprintf(writer, "Ошибка: %v\n", m.err)
+	}
+	fmt.Fprintf(writer, "Корень: %.8f\n", m.result.Root)
+	fmt.Fprintf(writer, "Итераций: %d\n", m.result.Iterations)
+	if p := root_finding.ConvergenceOrder(m.result.Errors); !math.IsNaN(p) {
+		fmt.Fprintf(writer, "Эмпирический порядок сходимости: %.2f\n", p)
+	}
+	if m.result.Converged {
+		fmt.Fprintln(writer, "Сходимость: достигнута")
+	} else {
+		fmt.Fprintln(writer, "Сходимость: не достигнута")
+	}
+	fmt.Fprintln(writer, "Погрешности по итерациям:")
+	for i, err := range m.result.Errors {
+		fmt.Fprintf(writer, "%3d: %.3e\n", i+1, err)
+	}
 }
 
-func newtonMethod(f, df func(float64) float64, params Params) (float64, []float64, int) {
-	x := (params.a + params.b) / 2
-	errors := []float64{}
+// allRoots — результаты поиска всех корней на отрезке и корней многочлена
+type allRoots struct {
+	// multiplicity — оценка кратности корня, найденного методом Ньютона
+	multiplicity float64
+
+	roots     []root_finding.Root
+	rootsErr  error
+	polyRoots []complex128
+	polyErr   error
 
-	for i := 0; i < params.maxIter; i++ {
-		fx := f(x)
-		dfx := df(x)
+	// Одновременное уточнение всех корней многочлена
+	durandKerner, aberth       root_finding.PolynomialResult
+	durandKernerErr, aberthErr error
 
-		if math.Abs(dfx) < 1e-12 {
-			return math.NaN(), errors, i + 1
+	// Комплексный корень f из начального приближения z0
+	complexNewton, muller       root_finding.ComplexResult
+	complexNewtonErr, mullerErr error
+}
+
+func writeAllRoots(writer *bufio.Writer, input Input, all allRoots) {
+	fmt.Fprintf(writer, "\nВсе корни на [%.2f, %.2f] (подынтервалов: %d):\n", input.a, input.b, input.scanIntervals)
+	if all.rootsErr != nil {
+		fmt.Fprintf(writer, "Ошибка: %v\n", all.rootsErr)
+	} else if len(all.roots) == 0 {
+		fmt.Fprintln(writer, "Корни не найдены")
+	}
+	for i, r := range all.roots {
+		kind := "смена знака"
+		if r.Even {
+			kind = "чётная кратность, без смены знака"
 		}
+		fmt.Fprintf(writer, "x%d = %.10f, f(x) = %.3e (%s)\n", i+1, r.X, r.F, kind)
+	}
 
-		xNew := x - fx/dfx
-		err := math.Abs(xNew - x)
-		errors = append(errors, err)
+	if input.poly == nil {
+		return
+	}
+	fmt.Fprintf(writer, "\nКорни многочлена с коэффициентами %v (сопровождающая матрица, QR-алгоритм):\n", input.poly)
+	if all.polyErr != nil {
+		fmt.Fprintf(writer, "Ошибка: %v\n", all.polyErr)
+		return
+	}
+	for i, z := range all.polyRoots {
+		p, _ := root_finding.Horner(input.poly, z)
+		fmt.Fprintf(writer, "z%d = %s (|p(z)| = %.3e)\n", i+1, formatComplex(z), cmplx.Abs(p))
+	}
 
-		if err < params.epsilon {
-			return xNew, errors, i + 1
+	writePolynomialMethod(writer, input, "Метод Дюрана–Кернера", all.durandKerner, all.durandKernerErr)
+	writePolynomialMethod(writer, input, "Метод Аберта", all.aberth, all.aberthErr)
+}
+
+func writePolynomialMethod(writer *bufio.Writer, input Input, name string, res root_finding.PolynomialResult, err error) {
+	fmt.Fprintf(writer, "\n%s (итераций: %d", name, res.Iterations)
+	if p := root_finding.ConvergenceOrder(res.Errors); !math.IsNaN(p) {
+		fmt.Fprintf(writer, ", эмпирический порядок %.2f", p)
+	}
+	fmt.Fprintln(writer, "):")
+	if err != nil {
+		fmt.Fprintf(writer, "Ошибка: %v\n", err)
+		return
+	}
+	if !res.Converged {
+		fmt.Fprintln(writer, "Сходимость не достигнута")
+	}
+	for i, z := range res.Roots {
+		p, _ := root_finding.Horner(input.poly, z)
+		fmt.Fprintf(writer, "z%d = %s (|p(z)| = %.3e)\n", i+1, formatComplex(z), cmplx.Abs(p))
+	}
+}
+
+func writeComplexRoots(writer *bufio.Writer, input Input, eq Equation, all allRoots) {
+	fmt.Fprintf(writer, "\nКомплексный корень f из z0 = %s:\n", formatComplex(input.z0))
+	if eq.complexErr != nil {
+		fmt.Fprintf(writer, "Ошибка: %v\n", eq.complexErr)
+		return
+	}
+	methods := []struct {
+		name string
+		res  root_finding.ComplexResult
+		err  error
+	}{
+		{"Метод Ньютона", all.complexNewton, all.complexNewtonErr},
+		{"Метод Мюллера", all.muller, all.mullerErr},
+	}
+	for _, m := range methods {
+		fmt.Fprintf(writer, "%s: ", m.name)
+		if m.err != nil {
+			fmt.Fprintf(writer, "ошибка: %v\n", m.err)
+			continue
+		}
+		fmt.Fprintf(writer, "z = %s, |f(z)| = %.3e, итераций: %d", formatComplex(m.res.Root), cmplx.Abs(eq.fc(m.res.Root)), m.res.Iterations)
+		if p := root_finding.ConvergenceOrder(m.res.Errors); !math.IsNaN(p) {
+			fmt.Fprintf(writer, ", порядок %.2f", p)
 		}
-		x = xNew
+		if !m.res.Converged {
+			fmt.Fprint(writer, " (сходимость не достигнута)")
+		}
+		fmt.Fprintln(writer)
 	}
+}
 
-	return x, errors, params.maxIter
+func formatComplex(z complex128) string {
+	if imag(z) == 0 {
+		return fmt.Sprintf("%.10f", real(z))
+	}
+	return fmt.Sprintf("%.10f %+.10fi", real(z), imag(z))
 }
 
-func writeResults(filename string, params Params, rootIter, rootNewton float64,
-	errorsIter, errorsNewton []float64, iterIter, iterNewton int) error {
+// writeAcceleration сравнивает простую итерацию с ускоренными вариантами.
+func writeAcceleration(writer *bufio.Writer, results []methodResult) {
+	fmt.Fprintln(writer, "\nУскорение метода простой итерации:")
+	fmt.Fprintf(writer, "%-30s %10s %12s %12s\n", "Метод", "Итераций", "Вычисл. φ", "Сходимость")
+	for _, m := range results {
+		if m.result.PhiEvals == 0 {
+			continue
+		}
+		status := "да"
+		if m.err != nil || !m.result.Converged {
+			status = "нет"
+		}
+		fmt.Fprintf(writer, "%-30s %10d %12d %12s\n", m.name, m.result.Iterations, m.result.PhiEvals, status)
+	}
+}
 
+func writeResults(filename string, input Input, eq Equation, all allRoots, results []methodResult) error {
 	file, err := os.Create(filename)
 	if err != nil {
 		return err
@@ -126,52 +426,190 @@ func writeResults(filename string, params Params, rootIter, rootNewton float64,
 	writer := bufio.NewWriter(file)
 	defer writer.Flush()
 
-	fmt.Fprintln(writer, "Анализ уравнения: ln(x + 2) - x⁴ + 0.5 = 0")
-	fmt.Fprintln(writer, "Представлено как пересечение: ln(x + 2) = x⁴ - 0.5")
-	fmt.Fprintf(writer, "\nИнтервал поиска: [%.2f, %.2f]\n", params.a, params.b)
-	fmt.Fprintf(writer, "Точность: %.0e\n", params.epsilon)
-	fmt.Fprintf(writer, "Макс. итераций: %d\n", params.maxIter)
+	fmt.Fprintf(writer, "Анализ уравнения: %s = 0\n", input.fSrc)
+	if input.dfSymbolic {
+		fmt.Fprintf(writer, "Производная (символьно): f'(x) = %s\n", input.dfSrc)
+	} else {
+		fmt.Fprintf(writer, "Производная: f'(x) = %s\n", input.dfSrc)
+	}
+	if eq.phiAuto {
+		fmt.Fprintf(writer, "Функция итераций (построена автоматически): φ(x) = x − λ·f(x), λ = 1/f'(x0) = %.6f\n", eq.lambda)
+	} else {
+		fmt.Fprintf(writer, "Функция итераций: φ(x) = %s\n", input.phiSrc)
+	}
+	names := make([]string, 0, len(input.constants))
+	for name := range input.constants {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		fmt.Fprintf(writer, "Параметр %s = %g\n", name, input.constants[name])
+	}
+	if eq.check != nil {
+		if eq.checkErr != nil {
+			fmt.Fprintf(writer, "Проверка производной: %v\n", eq.checkErr)
+		} else {
+			fmt.Fprintf(writer, "Проверка производной конечными разностями (точек: %d): макс. отклонение %.3e в x = %.6f (f' = %.8f, разность = %.8f)\n",
+				eq.check.Points, eq.check.Error, eq.check.Point[0], eq.check.Derivative, eq.check.FiniteDiff)
+			if eq.check.Error > 1e-5 {
+				fmt.Fprintln(writer, "Внимание: производная df, вероятно, задана с ошибкой")
+			}
+		}
+	}
+	fmt.Fprintf(writer, "\nИнтервал поиска: [%.2f, %.2f]\n", input.a, input.b)
+	fmt.Fprintf(writer, "Точность: %.0e\n", input.params.AbsTol)
+	if input.params.RelTol > 0 {
+		fmt.Fprintf(writer, "Относительная точность: %.0e\n", input.params.RelTol)
+	}
+	if input.params.FTol > 0 {
+		fmt.Fprintf(writer, "Точность по функции: %.0e\n", input.params.FTol)
+	}
+	fmt.Fprintf(writer, "Макс. итераций: %d\n", input.params.MaxIter)
+
+	fmt.Fprintf(writer, "\nАнализ сжимаемости φ на [%.2f, %.2f] (точек: %d):\n", input.a, input.b, contractionSamples)
+	if eq.analysisErr != nil {
+		fmt.Fprintf(writer, "Ошибка: %v\n", eq.analysisErr)
+	} else {
+		c := eq.contraction
+		fmt.Fprintf(writer, "q = max|φ'(x)| ≈ %.6f (в x = %.6f)\n", c.Q, c.WorstPoint)
+		fmt.Fprintf(writer, "φ([a, b]) ≈ [%.6f, %.6f]", c.ImageLo, c.ImageHi)
+		if c.SelfMapping {
+			fmt.Fprintln(writer, " ⊂ [a, b]")
+		} else {
+			fmt.Fprintln(writer, " — отрезок не отображается в себя")
+		}
+		if c.IsContraction() {
+			fmt.Fprintf(writer, "φ — сжатие; остановка по оценке q/(1−q)·|x_k − x_{k−1}| с множителем %.6f\n", c.Q/(1-c.Q))
+		} else if c.NearUnity() {
+			fmt.Fprintf(writer, "Внимание: q ≈ 1 − %.1e, сжатие по выборке не доказано\n", 1-c.Q)
+		} else {
+			fmt.Fprintln(writer, "Внимание: условия сходимости метода простой итерации не выполнены")
+		}
+	}
 
-	fmt.Fprintln(writer, "\nМетод простой итерации:")
-	fmt.Fprintf(writer, "Корень: %.8f\n", rootIter)
-	fmt.Fprintf(writer, "Итераций: %d\n", iterIter)
-	fmt.Fprintln(writer, "Погрешности по итерациям:")
-	for i, err := range errorsIter {
-		fmt.Fprintf(writer, "%3d: %.3e\n", i+1, err)
+	writeAllRoots(writer, input, all)
+	if input.complex {
+		writeComplexRoots(writer, input, eq, all)
 	}
 
-	fmt.Fprintln(writer, "\nМетод Ньютона:")
-	fmt.Fprintf(writer, "Корень: %.8f\n", rootNewton)
-	fmt.Fprintf(writer, "Итераций: %d\n", iterNewton)
-	fmt.Fprintln(writer, "Погрешности по итерациям:")
-	for i, err := range errorsNewton {
-		fmt.Fprintf(writer, "%3d: %.3e\n", i+1, err)
+	if !math.IsNaN(all.multiplicity) {
+		fmt.Fprintf(writer, "\nОценка кратности корня по методу Ньютона: m ≈ %.3f\n", all.multiplicity)
+		if math.Round(all.multiplicity) > 1 {
+			fmt.Fprintln(writer, "Корень кратный: метод Ньютона сходится линейно, используйте модифицированные методы")
+		}
+	}
+
+	fmt.Fprintln(writer, "\nСводка:")
+	fmt.Fprintf(writer, "%-32s %14s %10s %8s\n", "Метод", "Корень", "Итераций", "Порядок")
+	for _, m := range results {
+		order := "—"
+		if p := root_finding.ConvergenceOrder(m.result.Errors); !math.IsNaN(p) {
+			order = fmt.Sprintf("%.2f", p)
+		}
+		fmt.Fprintf(writer, "%-32s %14.8f %10d %8s\n", m.name, m.result.Root, m.result.Iterations, order)
+	}
+
+	writeAcceleration(writer, results)
+
+	for _, m := range results {
+		writeMethod(writer, m)
 	}
 
 	return nil
 }
 
 func main() {
-	f := func(x float64) float64 { return math.Log(x+2) - math.Pow(x, 4) + 0.5 }
-	df := func(x float64) float64 { return 1/(x+2) - 4*math.Pow(x, 3) }
-	phi := func(x float64) float64 { return math.Pow(math.Log(x+2)+0.5, 0.25) }
-
-	params, err := readInput("input.txt")
+	input, err := readInput("input.txt")
 	if err != nil {
 		fmt.Printf("Ошибка чтения: %v\n", err)
 		return
 	}
 
-	if f(params.a)*f(params.b) > 0 {
-		fmt.Println("Ошибка: на концах интервала функция имеет одинаковые знаки")
+	eq, err := compileEquation(&input)
+	if err != nil {
+		fmt.Printf("Ошибка разбора уравнения: %v\n", err)
 		return
 	}
+	f, df, phi := eq.f, eq.df, eq.phi
+
+	a, b, params := input.a, input.b, input.params
+
+	all := allRoots{multiplicity: math.NaN()}
+	all.roots, all.rootsErr = root_finding.FindRoots(f, a, b, input.scanIntervals, params)
+	if input.poly != nil {
+		all.polyRoots, all.polyErr = root_finding.PolynomialRoots(input.poly, 1e-12)
+		coeffs := make([]complex128, len(input.poly))
+		for i, c := range input.poly {
+			coeffs[i] = complex(c, 0)
+		}
+		all.durandKerner, all.durandKernerErr = root_finding.DurandKerner(coeffs, params)
+		all.aberth, all.aberthErr = root_finding.Aberth(coeffs, params)
+	}
+	if input.complex && eq.complexErr == nil {
+		z0 := input.z0
+		all.complexNewton, all.complexNewtonErr = root_finding.ComplexNewton(eq.fc, eq.dfc, z0, params)
+		all.muller, all.mullerErr = root_finding.Muller(eq.fc, z0-0.5, z0+0.5, z0, params)
+	}
+	x0 := (a + b) / 2
+	results := []methodResult{}
+	add := func(name string, res root_finding.Result, err error) {
+		results = append(results, methodResult{name: name, result: res, err: err})
+	}
 
-	rootIter, errorsIter, iterIter := iterationMethod(phi, params)
-	rootNewton, errorsNewton, iterNewton := newtonMethod(f, df, params)
+	var res root_finding.Result
+	if eq.analysisErr == nil && eq.contraction.IsContraction() {
+		res, err = root_finding.ContractiveFixedPoint(phi, x0, eq.contraction.Q, params)
+	} else {
+		res, err = root_finding.FixedPoint(phi, x0, params)
+	}
+	add("Метод простой итерации", res, err)
+	res, err = root_finding.Aitken(phi, x0, params)
+	add("Простая итерация + Эйткен Δ²", res, err)
+	res, err = root_finding.Steffensen(phi, x0, params)
+	add("Метод Стеффенсена", res, err)
+	{
+		// Методы ньютоновского типа; m — оценка кратности для
+		// модифицированного метода, нужна только здесь
+		res, err = root_finding.Newton(f, df, x0, params)
+		add("Метод Ньютона", res, err)
+		// Кратность оценивается и по последнему приближению неудавшегося метода:
+		// у кратного корня Ньютон сходится медленно и может остановиться раньше.
+		if res.Iterations > 0 && !math.IsNaN(res.Root) && !math.IsInf(res.Root, 0) {
+			if eq.d2f != nil {
+				all.multiplicity = root_finding.EstimateMultiplicity(f, df, eq.d2f, res.Root)
+			}
+			if math.IsNaN(all.multiplicity) || all.multiplicity == 0 {
+				all.multiplicity = root_finding.MultiplicityFromErrors(res.Errors)
+			}
+		}
+		m := 1
+		if !math.IsNaN(all.multiplicity) {
+			m = max(1, int(math.Round(all.multiplicity)))
+		}
+
+		res, err = root_finding.SafeNewton(f, df, a, b, params)
+		add("Метод Ньютона с защитой", res, err)
+		res, err = root_finding.NewtonAD(eq.fDual, x0, params)
+		add("Метод Ньютона (авт. дифф.)", res, err)
+		res, err = root_finding.ModifiedNewton(f, df, m, x0, params)
+		add(fmt.Sprintf("Метод Ньютона, m·f/f' (m = %d)", m), res, err)
+		if eq.d2f != nil {
+			res, err = root_finding.NewtonQuotient(f, df, eq.d2f, x0, params)
+			add("Метод Ньютона для f/f'", res, err)
+			res, err = root_finding.Halley(f, df, eq.d2f, x0, params)
+			add("Метод Галлея", res, err)
+		}
+	}
+	res, err = root_finding.Bisection(f, a, b, params)
+	add("Метод бисекции", res, err)
+	res, err = root_finding.RegulaFalsi(f, a, b, params)
+	add("Метод хорд (Illinois)", res, err)
+	res, err = root_finding.Secant(f, a, b, params)
+	add("Метод секущих", res, err)
+	res, err = root_finding.Brent(f, a, b, params)
+	add("Метод Брента", res, err)
 
-	if err := writeResults("output.txt", params, rootIter, rootNewton,
-		errorsIter, errorsNewton, iterIter, iterNewton); err != nil {
+	if err := writeResults("output.txt", input, eq, all, results); err != nil {
 		fmt.Printf("Ошибка записи: %v\n", err)
 		return
 	}
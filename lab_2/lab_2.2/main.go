@@ -5,177 +5,571 @@ import (
 	"fmt"
 	"math"
 	"os"
+	"sort"
 	"strconv"
 	"strings"
+
+	"github.com/KaiserRed/numeric_methods/internal/autodiff"
+	"github.com/KaiserRed/numeric_methods/internal/expr"
+	"github.com/KaiserRed/numeric_methods/internal/nonlinear"
+	"github.com/KaiserRed/numeric_methods/internal/root_finding"
 )
 
-type Vector struct {
-	X, Y float64
+// Система по умолчанию, если в файле не заданы f1 и f2
+var defaultSources = map[string]string{
+	"f1":    "a*x^2 - x + y^2 - 1",
+	"f2":    "y - tan(x)",
+	"df1dx": "2*a*x - 1",
+	"df1dy": "2*y",
+	"df2dx": "-1/cos(x)^2",
+	"df2dy": "1",
 }
 
-const a = 2.0
+var functionKeys = []string{"f1", "f2", "df1dx", "df1dy", "df2dx", "df2dy", "phi1", "phi2"}
 
-var (
-	epsilon float64
-	maxIter int
-	output  *os.File
-	writer  *bufio.Writer
-)
+var jacobianKeys = [2][2]string{{"df1dx", "df1dy"}, {"df2dx", "df2dy"}}
+
+// Полуширина области вокруг начального приближения для проверки производных
+// и для анализа сжимаемости φ, если область не задана строкой box=
+const checkRadius = 0.5
+
+// Число точек сетки по каждой координате при оценке коэффициента сжатия
+const contractionGrid = 41
+
+type System struct {
+	sources   map[string]string
+	constants map[string]float64
+	symbolic  map[string]bool
+	nodes     map[string]expr.Node
+
+	checkPoints int
+	check       *expr.DerivativeCheck
+	checkErr    error
+
+	f1, f2                     func(x, y float64) float64
+	df1dx, df1dy, df2dx, df2dy func(x, y float64) float64
+	phi1, phi2                 func(x, y float64) float64
+	phiJacobian                [2][2]func(x, y float64) float64
+
+	// residual — F(x, y) над дуальными числами для автоматического дифференцирования
+	residual func(v []autodiff.Dual) []autodiff.Dual
+}
+
+// Стратегии глобализации метода Ньютона, задаются строкой strategy=lm,dogleg
+var strategyNames = map[string]nonlinear.Strategy{
+	"full":       nonlinear.FullStep,
+	"linesearch": nonlinear.LineSearch,
+	"dogleg":     nonlinear.TrustRegion,
+	"lm":         nonlinear.LevenbergMarquardt,
+}
+
+// Config — начальное приближение, параметры остановки и выбранные стратегии
+type Config struct {
+	start      []float64
+	params     nonlinear.Params
+	strategies []nonlinear.Strategy
 
-func write(format string, args ...any) {
-	fmt.Fprintf(writer, format, args...)
+	// broydenRefresh — период пересчёта якобиана в методе Бройдена
+	broydenRefresh int
+
+	// box — область анализа сжимаемости φ
+	box nonlinear.Box
+
+	// andersonDepth — глубина истории ускорения Андерсона
+	andersonDepth int
 }
 
-func parseInputFile(filename string) (Vector, error) {
+func parseInputFile(filename string) (Config, System, error) {
 	file, err := os.Open(filename)
 	if err != nil {
-		return Vector{}, err
+		return Config{}, System{}, err
 	}
 	defer file.Close()
 
-	start := Vector{}
+	cfg := Config{start: make([]float64, 2), params: nonlinear.Params{MaxIter: 100}, andersonDepth: 3}
+	sys := System{
+		sources:   map[string]string{},
+		constants: map[string]float64{"a": 2},
+		symbolic:  map[string]bool{},
+		nodes:     map[string]expr.Node{},
+	}
 	scanner := bufio.NewScanner(file)
 
 	for scanner.Scan() {
 		line := scanner.Text()
 		if strings.HasPrefix(line, "x0=") {
-			start.X, _ = strconv.ParseFloat(strings.TrimPrefix(line, "x0="), 64)
+			cfg.start[0], _ = strconv.ParseFloat(strings.TrimPrefix(line, "x0="), 64)
 		} else if strings.HasPrefix(line, "y0=") {
-			start.Y, _ = strconv.ParseFloat(strings.TrimPrefix(line, "y0="), 64)
+			cfg.start[1], _ = strconv.ParseFloat(strings.TrimPrefix(line, "y0="), 64)
 		} else if strings.HasPrefix(line, "epsilon=") {
-			epsilon, _ = strconv.ParseFloat(strings.TrimPrefix(line, "epsilon="), 64)
+			cfg.params.AbsTol, _ = strconv.ParseFloat(strings.TrimPrefix(line, "epsilon="), 64)
 		} else if strings.HasPrefix(line, "maxIter=") {
-			maxIter, _ = strconv.Atoi(strings.TrimPrefix(line, "maxIter="))
+			cfg.params.MaxIter, _ = strconv.Atoi(strings.TrimPrefix(line, "maxIter="))
+		} else if strings.HasPrefix(line, "strategy=") {
+			for _, name := range strings.Split(strings.TrimPrefix(line, "strategy="), ",") {
+				strategy, ok := strategyNames[strings.TrimSpace(name)]
+				if !ok {
+					return Config{}, System{}, fmt.Errorf("неизвестная стратегия %q (допустимы full, linesearch, dogleg, lm)", name)
+				}
+				cfg.strategies = append(cfg.strategies, strategy)
+			}
+		} else if strings.HasPrefix(line, "broyden_refresh=") {
+			cfg.broydenRefresh, _ = strconv.Atoi(strings.TrimPrefix(line, "broyden_refresh="))
+		} else if strings.HasPrefix(line, "anderson_depth=") {
+			cfg.andersonDepth, err = strconv.Atoi(strings.TrimPrefix(line, "anderson_depth="))
+			if err != nil || cfg.andersonDepth < 0 {
+				return Config{}, System{}, fmt.Errorf("ошибка чтения anderson_depth: ожидалось неотрицательное число")
+			}
+		} else if strings.HasPrefix(line, "box=") {
+			fields := strings.Split(strings.TrimPrefix(line, "box="), ",")
+			if len(fields) != 4 {
+				return Config{}, System{}, fmt.Errorf("ожидалось: box=x_min,x_max,y_min,y_max")
+			}
+			bounds := make([]float64, 4)
+			for i, field := range fields {
+				bounds[i], err = strconv.ParseFloat(strings.TrimSpace(field), 64)
+				if err != nil {
+					return Config{}, System{}, fmt.Errorf("ошибка чтения box: %v", err)
+				}
+			}
+			cfg.box = nonlinear.Box{Lo: []float64{bounds[0], bounds[2]}, Hi: []float64{bounds[1], bounds[3]}}
+		} else if strings.HasPrefix(line, "check=") {
+			sys.checkPoints, _ = strconv.Atoi(strings.TrimPrefix(line, "check="))
+		} else if strings.HasPrefix(line, "param ") {
+			name, value, ok := strings.Cut(strings.TrimPrefix(line, "param "), "=")
+			if !ok {
+				return Config{}, System{}, fmt.Errorf("ожидалось: param <имя>=<значение>")
+			}
+			val, err := strconv.ParseFloat(strings.TrimSpace(value), 64)
+			if err != nil {
+				return Config{}, System{}, fmt.Errorf("ошибка чтения параметра %s: %v", name, err)
+			}
+			sys.constants[strings.TrimSpace(name)] = val
+		} else if key, value, ok := strings.Cut(line, "="); ok {
+			key = strings.TrimSpace(key)
+			for _, k := range functionKeys {
+				if key == k {
+					sys.sources[k] = strings.TrimSpace(value)
+				}
+			}
 		}
 	}
 
 	if err := scanner.Err(); err != nil {
-		return Vector{}, err
+		return Config{}, System{}, err
 	}
 
-	return start, nil
-}
+	if cfg.box.Lo == nil {
+		cfg.box = nonlinear.Box{
+			Lo: []float64{cfg.start[0] - checkRadius, cfg.start[1] - checkRadius},
+			Hi: []float64{cfg.start[0] + checkRadius, cfg.start[1] + checkRadius},
+		}
+	}
+	if len(cfg.strategies) == 0 {
+		cfg.strategies = []nonlinear.Strategy{nonlinear.FullStep}
+	}
+
+	if sys.sources["f1"] == "" && sys.sources["f2"] == "" {
+		for k, v := range defaultSources {
+			if _, ok := sys.sources[k]; !ok {
+				sys.sources[k] = v
+			}
+		}
+	}
+	if err := sys.compile(); err != nil {
+		return Config{}, System{}, err
+	}
+	if sys.checkPoints > 0 {
+		sys.checkJacobian(cfg.start)
+	}
 
-func f1(x, y float64) float64 {
-	return a*x*x - x + y*y - 1
+	return cfg, sys, nil
 }
 
-func f2(x, y float64) float64 {
-	return y - math.Tan(x)
+// compile разбирает выражения системы. Недостающие частные производные
+// строятся символьным дифференцированием f1 и f2.
+func (sys *System) compile() error {
+	targets := map[string]*func(x, y float64) float64{
+		"f1": &sys.f1, "f2": &sys.f2,
+		"df1dx": &sys.df1dx, "df1dy": &sys.df1dy, "df2dx": &sys.df2dx, "df2dy": &sys.df2dy,
+		"phi1": &sys.phi1, "phi2": &sys.phi2,
+	}
+	for _, key := range functionKeys {
+		src := sys.sources[key]
+		if src == "" {
+			if key == "f1" || key == "f2" {
+				return fmt.Errorf("не задана функция %s", key)
+			}
+			continue
+		}
+		n, err := expr.Parse(src)
+		if err != nil {
+			return fmt.Errorf("функция %s: %v", key, err)
+		}
+		sys.nodes[key] = n
+	}
+
+	vars := []string{"x", "y"}
+	for i, f := range []string{"f1", "f2"} {
+		for j, key := range jacobianKeys[i] {
+			if _, ok := sys.nodes[key]; ok {
+				continue
+			}
+			d, err := expr.Diff(sys.nodes[f], vars[j])
+			if err != nil {
+				return fmt.Errorf("функция %s: %v", key, err)
+			}
+			sys.nodes[key] = d
+			sys.sources[key] = d.String()
+			sys.symbolic[key] = true
+		}
+	}
+
+	for key, n := range sys.nodes {
+		fn, err := expr.Compile2(n, "x", "y", sys.constants)
+		if err != nil {
+			return fmt.Errorf("функция %s: %v", key, err)
+		}
+		*targets[key] = fn
+	}
+
+	f1Dual, err := expr.CompileDual(sys.nodes["f1"], vars, sys.constants)
+	if err != nil {
+		return fmt.Errorf("функция f1: %v", err)
+	}
+	f2Dual, err := expr.CompileDual(sys.nodes["f2"], vars, sys.constants)
+	if err != nil {
+		return fmt.Errorf("функция f2: %v", err)
+	}
+	sys.residual = func(v []autodiff.Dual) []autodiff.Dual {
+		return []autodiff.Dual{f1Dual(v), f2Dual(v)}
+	}
+
+	// Матрица Якоби φ для оценки коэффициента сжатия
+	if sys.phi1 != nil && sys.phi2 != nil {
+		jac, err := expr.Jacobian([]expr.Node{sys.nodes["phi1"], sys.nodes["phi2"]}, vars)
+		if err != nil {
+			return fmt.Errorf("производные φ: %v", err)
+		}
+		for i := range jac {
+			for j := range jac[i] {
+				sys.phiJacobian[i][j], err = expr.Compile2(jac[i][j], "x", "y", sys.constants)
+				if err != nil {
+					return fmt.Errorf("производные φ: %v", err)
+				}
+			}
+		}
+	}
+	return nil
 }
 
-func df1dx(x, y float64) float64 {
-	return 2*a*x - 1
+// checkJacobian сравнивает частные производные с конечными разностями
+// на сетке checkPoints×checkPoints вокруг начального приближения.
+func (sys *System) checkJacobian(start []float64) {
+	n := sys.checkPoints
+	points := make([][]float64, 0, n*n)
+	for i := 0; i < n; i++ {
+		for j := 0; j < n; j++ {
+			tx, ty := 0.0, 0.0
+			if n > 1 {
+				tx = 2*float64(i)/float64(n-1) - 1
+				ty = 2*float64(j)/float64(n-1) - 1
+			}
+			points = append(points, []float64{start[0] + checkRadius*tx, start[1] + checkRadius*ty})
+		}
+	}
+
+	fs := []expr.Node{sys.nodes["f1"], sys.nodes["f2"]}
+	jac := [][]expr.Node{
+		{sys.nodes["df1dx"], sys.nodes["df1dy"]},
+		{sys.nodes["df2dx"], sys.nodes["df2dy"]},
+	}
+	check, err := expr.CheckJacobian(fs, jac, []string{"x", "y"}, sys.constants, points)
+	sys.check, sys.checkErr = &check, err
 }
 
-func df1dy(x, y float64) float64 {
-	return 2 * y
+func printSystem(w *bufio.Writer, sys System) {
+	fmt.Fprintf(w, "Система уравнений:\n")
+	fmt.Fprintf(w, "f1(x, y) = %s = 0\n", sys.sources["f1"])
+	fmt.Fprintf(w, "f2(x, y) = %s = 0\n", sys.sources["f2"])
+	for i := range jacobianKeys {
+		for _, key := range jacobianKeys[i] {
+			if sys.symbolic[key] {
+				fmt.Fprintf(w, "%s = %s (символьно)\n", key, sys.sources[key])
+			} else {
+				fmt.Fprintf(w, "%s = %s\n", key, sys.sources[key])
+			}
+		}
+	}
+	if sys.phi1 != nil && sys.phi2 != nil {
+		fmt.Fprintf(w, "Итерационная функция: φ1(x, y) = %s, φ2(x, y) = %s\n", sys.sources["phi1"], sys.sources["phi2"])
+	}
+
+	names := make([]string, 0, len(sys.constants))
+	for name := range sys.constants {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		fmt.Fprintf(w, "Параметр %s = %g\n", name, sys.constants[name])
+	}
+
+	if sys.check != nil {
+		if sys.checkErr != nil {
+			fmt.Fprintf(w, "Проверка якобиана: %v\n", sys.checkErr)
+		} else {
+			c := sys.check
+			fmt.Fprintf(w, "Проверка якобиана конечными разностями (точек: %d): макс. отклонение %.3e в %s при (%.4f, %.4f): %.8f против %.8f\n",
+				c.Points, c.Error, jacobianKeys[c.Row][c.Col], c.Point[0], c.Point[1], c.Derivative, c.FiniteDiff)
+			if c.Error > 1e-5 {
+				fmt.Fprintf(w, "Внимание: частная производная %s, вероятно, задана с ошибкой\n", jacobianKeys[c.Row][c.Col])
+			}
+		}
+	}
 }
 
-func df2dx(x, y float64) float64 {
-	return -1 / (math.Cos(x) * math.Cos(x))
+// F возвращает вектор невязок (f1, f2).
+func (sys System) F(v []float64) []float64 {
+	return []float64{sys.f1(v[0], v[1]), sys.f2(v[0], v[1])}
 }
 
-func df2dy(x, y float64) float64 {
-	return 1
+// analytic использует заданные или символьно найденные частные производные.
+func (sys System) analytic(v []float64) [][]float64 {
+	x, y := v[0], v[1]
+	return [][]float64{
+		{sys.df1dx(x, y), sys.df1dy(x, y)},
+		{sys.df2dx(x, y), sys.df2dy(x, y)},
+	}
 }
 
-func phi(x, y float64) (float64, float64) {
-	yNext := math.Tan(x)
-	f1Val := f1(x, yNext)
-	xNext := x - 0.1*f1Val
-	return xNext, yNext
+// automatic получает якобиан автоматическим дифференцированием F.
+func (sys System) automatic(v []float64) [][]float64 {
+	_, J, _ := autodiff.Jacobian(sys.residual, v)
+	return J
 }
 
-func printSystem() {
-	write("Система уравнений:\n")
-	write("f1(x, y) = 2x^2 - x + y^2 - 1 = 0\n")
-	write("f2(x, y) = y - tan(x) = 0\n")
+// Обозначение параметра демпфирования в отчёте для каждой стратегии
+var dampingLabels = map[nonlinear.Strategy]string{
+	nonlinear.LineSearch:         "t",
+	nonlinear.TrustRegion:        "Δ",
+	nonlinear.LevenbergMarquardt: "μ",
 }
 
-func newtonMethod(start Vector) Vector {
-	write("\n--- Метод Ньютона ---\n")
-	x, y := start.X, start.Y
-	for i := 0; i < maxIter; i++ {
-		fx := f1(x, y)
-		fy := f2(x, y)
+// run — результат одного решателя для сводной таблицы
+type run struct {
+	title string
+	res   nonlinear.Result
+	err   error
+}
 
-		J := [2][2]float64{
-			{df1dx(x, y), df1dy(x, y)},
-			{df2dx(x, y), df2dy(x, y)},
-		}
-		det := J[0][0]*J[1][1] - J[0][1]*J[1][0]
-		if math.Abs(det) < 1e-12 {
-			write("Якобиан вырожден\n")
-			break
+func writeRun(w *bufio.Writer, r run, dampingLabel string) {
+	fmt.Fprintf(w, "\n--- %s ---\n", r.title)
+	for i, it := range r.res.History {
+		fmt.Fprintf(w, "Итерация %d: x = %.6f, y = %.6f, ошибка = %.6e, ½‖F‖² = %.3e",
+			i+1, it.X[0], it.X[1], it.StepNorm, it.Merit)
+		if dampingLabel != "" {
+			fmt.Fprintf(w, ", %s = %.3e", dampingLabel, it.Damping)
 		}
+		fmt.Fprintln(w)
+	}
+	if r.err != nil {
+		fmt.Fprintf(w, "Ошибка: %v\n", r.err)
+	} else if !r.res.Converged {
+		fmt.Fprintf(w, "Точность не достигнута за %d итераций\n", r.res.Iterations)
+	}
+	writeOrder(w, r.res)
+	fmt.Fprintf(w, "Вычислений F: %d, якобиана: %d\n", r.res.FEvals, r.res.JEvals)
+	fmt.Fprintf(w, "\nРезультат (%s): x = %.6f, y = %.6f\n", r.title, r.res.X[0], r.res.X[1])
+}
 
-		dx := (fx*J[1][1] - fy*J[0][1]) / det
-		dy := (fy*J[0][0] - fx*J[1][0]) / det
+// convergenceOrder оценивает порядок сходимости по нормам шагов той же
+// медианой по тройкам, что и для скалярных методов.
+func convergenceOrder(res nonlinear.Result) float64 {
+	steps := make([]float64, len(res.History))
+	for i, it := range res.History {
+		steps[i] = it.StepNorm
+	}
+	return root_finding.ConvergenceOrder(steps)
+}
 
-		xNext := x - dx
-		yNext := y - dy
+func writeOrder(w *bufio.Writer, res nonlinear.Result) {
+	if p := convergenceOrder(res); !math.IsNaN(p) {
+		fmt.Fprintf(w, "Эмпирический порядок сходимости: %.2f\n", p)
+	}
+}
 
-		errorVal := math.Hypot(xNext-x, yNext-y)
-		write("Итерация %d: x = %.6f, y = %.6f, ошибка = %.6e\n", i+1, xNext, yNext, errorVal)
+func newtonMethod(w *bufio.Writer, title string, sys nonlinear.System, cfg Config, strategy nonlinear.Strategy) run {
+	params := cfg.params
+	params.Strategy = strategy
+	res, err := nonlinear.Newton(sys, cfg.start, params)
+	r := run{title: title, res: res, err: err}
+	writeRun(w, r, dampingLabels[strategy])
+	return r
+}
+
+func broydenMethod(w *bufio.Writer, sys nonlinear.System, cfg Config, update nonlinear.BroydenUpdate) run {
+	opts := nonlinear.BroydenOptions{Update: update, Refresh: cfg.broydenRefresh}
+	res, err := nonlinear.Broyden(sys, cfg.start, cfg.params, opts)
+	r := run{title: fmt.Sprintf("Метод Бройдена (%v)", update), res: res, err: err}
+	writeRun(w, r, "")
+	return r
+}
 
-		if errorVal < epsilon {
-			return Vector{xNext, yNext}
+func writeSummary(w *bufio.Writer, runs []run) {
+	fmt.Fprintln(w, "\nСводка:")
+	fmt.Fprintf(w, "%-60s %10s %12s %10s %8s %10s\n", "Метод", "Итераций", "Вычисл. F/φ", "Якобианов", "Порядок", "Сходимость")
+	for _, r := range runs {
+		status := "да"
+		if r.err != nil || !r.res.Converged {
+			status = "нет"
 		}
-		x, y = xNext, yNext
+		order := "—"
+		if p := convergenceOrder(r.res); !math.IsNaN(p) {
+			order = fmt.Sprintf("%.2f", p)
+		}
+		fmt.Fprintf(w, "%-60s %10d %12d %10d %8s %10s\n", r.title, r.res.Iterations, r.res.FEvals, r.res.JEvals, order, status)
 	}
-	return Vector{x, y}
 }
 
-func simpleIteration(start Vector) Vector {
-	write("\n--- Метод простой итерации ---\n")
-	x, y := start.X, start.Y
-	for i := 0; i < maxIter; i++ {
-		xNext, yNext := phi(x, y)
+// phi и phiDerivative — заданная в файле итерационная функция и её матрица Якоби
+func (sys System) phi(v []float64) []float64 {
+	return []float64{sys.phi1(v[0], v[1]), sys.phi2(v[0], v[1])}
+}
 
-		if math.IsNaN(xNext) || math.IsNaN(yNext) || math.Abs(x) > math.Pi/2-1e-3 {
-			write("Получено недопустимое значение (NaN или x слишком близко к точке разрыва tan).\n")
-			break
-		}
+func (sys System) phiDerivative(v []float64) [][]float64 {
+	J := make([][]float64, 2)
+	for i := range J {
+		J[i] = []float64{sys.phiJacobian[i][0](v[0], v[1]), sys.phiJacobian[i][1](v[0], v[1])}
+	}
+	return J
+}
 
-		errorVal := math.Hypot(xNext-x, yNext-y)
-		write("Итерация %d: x = %.6f, y = %.6f, ошибка = %.6e\n", i+1, xNext, yNext, errorVal)
+// simpleIteration проверяет условия сходимости метода простой итерации на
+// области cfg.box и выполняет итерации. Если φ не задана, она строится
+// как φ(x) = x − Λ·F(x) с Λ = J(x0)⁻¹.
+func simpleIteration(w *bufio.Writer, sys System, cfg Config) (run, func([]float64) []float64) {
+	fmt.Fprintf(w, "\n--- Метод простой итерации ---\n")
+	var phi func([]float64) []float64
+	var dphi func([]float64) [][]float64
+	if sys.phi1 != nil && sys.phi2 != nil {
+		phi, dphi = sys.phi, sys.phiDerivative
+	} else {
+		var Lambda [][]float64
+		var err error
+		phi, dphi, Lambda, err = nonlinear.Relaxation(nonlinear.System{F: sys.F, J: sys.analytic}, cfg.start)
+		if err != nil {
+			fmt.Fprintf(w, "Не удалось построить φ: %v\n", err)
+			return run{title: "Метод простой итерации", res: nonlinear.Result{X: cfg.start}, err: err}, nil
+		}
+		fmt.Fprintf(w, "φ(x) = x − Λ·F(x), Λ = J(x0)⁻¹ = [[%.6f, %.6f], [%.6f, %.6f]]\n",
+			Lambda[0][0], Lambda[0][1], Lambda[1][0], Lambda[1][1])
+	}
 
-		if errorVal < epsilon {
-			return Vector{xNext, yNext}
+	box := cfg.box
+	fmt.Fprintf(w, "Анализ сжимаемости на [%.4f, %.4f] × [%.4f, %.4f]:\n", box.Lo[0], box.Hi[0], box.Lo[1], box.Hi[1])
+	q := -1.0
+	c, err := nonlinear.AnalyzeContraction(phi, dphi, box, contractionGrid)
+	if err != nil {
+		fmt.Fprintf(w, "Ошибка: %v\n", err)
+	} else {
+		fmt.Fprintf(w, "q = max‖φ'‖∞ ≈ %.6f (в точке (%.4f, %.4f), точек: %d)\n", c.Q, c.WorstPoint[0], c.WorstPoint[1], c.Samples)
+		fmt.Fprintf(w, "Образ области ≈ [%.4f, %.4f] × [%.4f, %.4f]", c.ImageLo[0], c.ImageHi[0], c.ImageLo[1], c.ImageHi[1])
+		if c.SelfMapping {
+			fmt.Fprintln(w, " — лежит в области")
+		} else {
+			fmt.Fprintln(w, " — выходит за пределы области")
+		}
+		if c.IsContraction() {
+			q = c.Q
+			fmt.Fprintf(w, "φ — сжатие; остановка по оценке q/(1−q)·‖x_k − x_{k−1}‖∞ с множителем %.6f\n", q/(1-q))
+		} else if c.NearUnity() {
+			fmt.Fprintf(w, "Внимание: q ≈ 1 − %.1e, сжатие по выборке не доказано\n", 1-c.Q)
+		} else {
+			fmt.Fprintln(w, "Внимание: условия сходимости метода простой итерации не выполнены")
 		}
+	}
 
-		x, y = xNext, yNext
+	res, err := nonlinear.FixedPoint(phi, cfg.start, q, cfg.params)
+	for i, it := range res.History {
+		fmt.Fprintf(w, "Итерация %d: x = %.6f, y = %.6f, ошибка = %.6e\n", i+1, it.X[0], it.X[1], it.Bound)
+	}
+	if err != nil {
+		fmt.Fprintf(w, "Ошибка: %v\n", err)
+	} else if !res.Converged {
+		fmt.Fprintf(w, "Точность не достигнута за %d итераций\n", res.Iterations)
 	}
-	return Vector{x, y}
+	writeOrder(w, res)
+	fmt.Fprintf(w, "\nРезультат (Метод простой итерации): x = %.6f, y = %.6f\n", res.X[0], res.X[1])
+	return run{title: "Метод простой итерации", res: res, err: err}, phi
+}
+
+// andersonMethod повторяет простую итерацию с ускорением Андерсона.
+func andersonMethod(w *bufio.Writer, phi func([]float64) []float64, cfg Config) run {
+	res, err := nonlinear.Anderson(phi, cfg.start, cfg.andersonDepth, cfg.params)
+	r := run{title: fmt.Sprintf("Простая итерация + Андерсон (m = %d)", cfg.andersonDepth), res: res, err: err}
+	fmt.Fprintf(w, "\n--- %s ---\n", r.title)
+	for i, it := range res.History {
+		fmt.Fprintf(w, "Итерация %d: x = %.6f, y = %.6f, ошибка = %.6e, ‖φ(x) − x‖∞ = %.3e\n",
+			i+1, it.X[0], it.X[1], it.StepNorm, it.FNorm)
+	}
+	if err != nil {
+		fmt.Fprintf(w, "Ошибка: %v\n", err)
+	} else if !res.Converged {
+		fmt.Fprintf(w, "Точность не достигнута за %d итераций\n", res.Iterations)
+	}
+	writeOrder(w, res)
+	fmt.Fprintf(w, "Вычислений φ: %d\n", res.FEvals)
+	fmt.Fprintf(w, "\nРезультат (%s): x = %.6f, y = %.6f\n", r.title, res.X[0], res.X[1])
+	return r
 }
 
 func main() {
-	var err error
-	output, err = os.Create("output.txt")
+	output, err := os.Create("output.txt")
 	if err != nil {
 		fmt.Println("Ошибка создания файла вывода:", err)
 		return
 	}
 	defer output.Close()
 
-	writer = bufio.NewWriter(output)
-	defer writer.Flush()
+	w := bufio.NewWriter(output)
+	defer w.Flush()
 
-	start, err := parseInputFile("input.txt")
+	cfg, sys, err := parseInputFile("input.txt")
 	if err != nil {
-		write("Ошибка чтения файла: %v\n", err)
+		fmt.Fprintf(w, "Ошибка чтения файла: %v\n", err)
 		return
 	}
 
-	printSystem()
-	write("\nНачальное приближение: x0 = %.6f, y0 = %.6f\n", start.X, start.Y)
-	write("Заданная точность: epsilon = %.6e\n", epsilon)
-	write("Максимальное число итераций: %d\n", maxIter)
+	printSystem(w, sys)
+	fmt.Fprintf(w, "\nНачальное приближение: x0 = %.6f, y0 = %.6f\n", cfg.start[0], cfg.start[1])
+	fmt.Fprintf(w, "Заданная точность: epsilon = %.6e\n", cfg.params.AbsTol)
+	fmt.Fprintf(w, "Максимальное число итераций: %d\n", cfg.params.MaxIter)
+	if cfg.broydenRefresh > 0 {
+		fmt.Fprintf(w, "Пересчёт якобиана в методе Бройдена: каждые %d итераций\n", cfg.broydenRefresh)
+	}
 
-	resultNewton := newtonMethod(start)
-	write("\nРезультат (Метод Ньютона): x = %.6f, y = %.6f\n", resultNewton.X, resultNewton.Y)
+	var runs []run
+	for _, strategy := range cfg.strategies {
+		title := "Метод Ньютона"
+		if strategy != nonlinear.FullStep {
+			title = fmt.Sprintf("Метод Ньютона (%v)", strategy)
+		}
+		runs = append(runs,
+			newtonMethod(w, title, nonlinear.System{F: sys.F, J: sys.analytic}, cfg, strategy),
+			newtonMethod(w, title+", авт. дифф.", nonlinear.System{F: sys.F, J: sys.automatic}, cfg, strategy),
+			newtonMethod(w, title+", конечные разности", nonlinear.System{F: sys.F}, cfg, strategy))
+	}
+
+	// Начальный якобиан метода Бройдена — конечными разностями
+	runs = append(runs,
+		broydenMethod(w, nonlinear.System{F: sys.F}, cfg, nonlinear.GoodBroyden),
+		broydenMethod(w, nonlinear.System{F: sys.F}, cfg, nonlinear.BadBroyden))
+
+	simple, phi := simpleIteration(w, sys, cfg)
+	runs = append(runs, simple)
+	if phi != nil {
+		runs = append(runs, andersonMethod(w, phi, cfg))
+	}
 
-	resultSimple := simpleIteration(start)
-	write("\nРезультат (Метод простой итерации): x = %.6f, y = %.6f\n", resultSimple.X, resultSimple.Y)
+	writeSummary(w, runs)
 }
@@ -0,0 +1,394 @@
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"image"
+	"image/color"
+	"image/png"
+	"math"
+	"os"
+	"runtime"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/KaiserRed/numeric_methods/internal/expr"
+	"github.com/KaiserRed/numeric_methods/internal/nonlinear"
+)
+
+// Система по умолчанию — та же, что в lab_2.2
+const (
+	defaultF1 = "a*x^2 - x + y^2 - 1"
+	defaultF2 = "y - tan(x)"
+)
+
+// Цвета бассейнов; корни сверх палитры окрашиваются по кругу
+var palette = []color.RGBA{
+	{230, 57, 70, 255},
+	{69, 123, 157, 255},
+	{42, 157, 143, 255},
+	{233, 196, 106, 255},
+	{155, 93, 229, 255},
+	{244, 162, 97, 255},
+	{29, 53, 87, 255},
+	{131, 197, 190, 255},
+}
+
+// Config — область и разрешение сетки начальных приближений, метод и его параметры
+type Config struct {
+	xMin, xMax, yMin, yMax float64
+	width, height          int
+	method                 string
+	params                 nonlinear.Params
+
+	sources   map[string]string
+	constants map[string]float64
+}
+
+// pixel — исход итераций из одной начальной точки
+type pixel struct {
+	x          []float64
+	iterations int
+	converged  bool
+}
+
+// basin — найденный корень и статистика его бассейна
+type basin struct {
+	root       []float64
+	pixels     int
+	iterations int
+}
+
+func main() {
+	cfg, err := readInput("input.txt")
+	if err != nil {
+		fmt.Printf("Ошибка чтения: %v\n", err)
+		return
+	}
+
+	solve, err := buildSolver(cfg)
+	if err != nil {
+		fmt.Printf("Ошибка разбора системы: %v\n", err)
+		return
+	}
+
+	pixels := computeGrid(cfg, solve)
+	basins, labels := classify(cfg, pixels)
+
+	if err := writePNG("basins.png", cfg, pixels, labels); err != nil {
+		fmt.Printf("Ошибка записи PNG: %v\n", err)
+		return
+	}
+	if err := writeResults("output.txt", cfg, pixels, basins); err != nil {
+		fmt.Printf("Ошибка записи: %v\n", err)
+		return
+	}
+
+	fmt.Println("Вычисления завершены. Изображение в basins.png, сводка в output.txt")
+}
+
+// Формат файла: строки key=value; f1=, f2= (по умолчанию система lab_2.2),
+// phi1=, phi2= для метода простой итерации, param a=2, x_min=, x_max=,
+// y_min=, y_max=, width=, height=, epsilon=, maxIter=,
+// method=newton|linesearch|dogleg|lm|iteration.
+func readInput(filename string) (Config, error) {
+	file, err := os.Open(filename)
+	if err != nil {
+		return Config{}, err
+	}
+	defer file.Close()
+
+	cfg := Config{
+		xMin: -2, xMax: 2, yMin: -2, yMax: 2,
+		width: 400, height: 400,
+		method:    "newton",
+		params:    nonlinear.Params{AbsTol: 1e-8, MaxIter: 50},
+		sources:   map[string]string{"f1": defaultF1, "f2": defaultF2},
+		constants: map[string]float64{"a": 2},
+	}
+	floats := map[string]*float64{
+		"x_min": &cfg.xMin, "x_max": &cfg.xMax, "y_min": &cfg.yMin, "y_max": &cfg.yMax,
+		"epsilon": &cfg.params.AbsTol,
+	}
+	ints := map[string]*int{"width": &cfg.width, "height": &cfg.height, "maxIter": &cfg.params.MaxIter}
+
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		if strings.HasPrefix(line, "param ") {
+			name, value, ok := strings.Cut(strings.TrimPrefix(line, "param "), "=")
+			if !ok {
+				return Config{}, fmt.Errorf("ожидалось: param <имя>=<значение>")
+			}
+			val, err := strconv.ParseFloat(strings.TrimSpace(value), 64)
+			if err != nil {
+				return Config{}, fmt.Errorf("ошибка чтения параметра %s: %v", name, err)
+			}
+			cfg.constants[strings.TrimSpace(name)] = val
+			continue
+		}
+
+		key, value, ok := strings.Cut(line, "=")
+		if !ok {
+			return Config{}, fmt.Errorf("ожидалось: <ключ>=<значение>, получено %q", line)
+		}
+		key, value = strings.TrimSpace(key), strings.TrimSpace(value)
+		switch {
+		case floats[key] != nil:
+			val, err := strconv.ParseFloat(value, 64)
+			if err != nil {
+				return Config{}, fmt.Errorf("ошибка чтения %s: %v", key, err)
+			}
+			*floats[key] = val
+		case ints[key] != nil:
+			val, err := strconv.Atoi(value)
+			if err != nil {
+				return Config{}, fmt.Errorf("ошибка чтения %s: %v", key, err)
+			}
+			*ints[key] = val
+		case key == "method":
+			cfg.method = value
+		case key == "f1" || key == "f2" || key == "phi1" || key == "phi2":
+			cfg.sources[key] = value
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return Config{}, err
+	}
+
+	if cfg.xMin >= cfg.xMax || cfg.yMin >= cfg.yMax {
+		return Config{}, fmt.Errorf("пустая область начальных приближений")
+	}
+	if cfg.width < 1 || cfg.height < 1 {
+		return Config{}, fmt.Errorf("размеры изображения должны быть положительными")
+	}
+	return cfg, nil
+}
+
+// buildSolver возвращает функцию, выполняющую выбранный метод из одной
+// начальной точки. Замыкания не имеют общего изменяемого состояния, поэтому
+// её можно вызывать из нескольких горутин.
+func buildSolver(cfg Config) (func(x0 []float64) (nonlinear.Result, error), error) {
+	vars := []string{"x", "y"}
+	compile := func(keys ...string) ([]func([]float64) float64, []expr.Node, error) {
+		fns := make([]func([]float64) float64, len(keys))
+		nodes := make([]expr.Node, len(keys))
+		for i, key := range keys {
+			src := cfg.sources[key]
+			if src == "" {
+				return nil, nil, fmt.Errorf("не задана функция %s", key)
+			}
+			n, err := expr.Parse(src)
+			if err != nil {
+				return nil, nil, fmt.Errorf("функция %s: %v", key, err)
+			}
+			fns[i], err = expr.Compile(n, vars, cfg.constants)
+			if err != nil {
+				return nil, nil, fmt.Errorf("функция %s: %v", key, err)
+			}
+			nodes[i] = n
+		}
+		return fns, nodes, nil
+	}
+	vector := func(fns []func([]float64) float64) func([]float64) []float64 {
+		return func(v []float64) []float64 {
+			return []float64{fns[0](v), fns[1](v)}
+		}
+	}
+
+	if cfg.method == "iteration" {
+		phi, _, err := compile("phi1", "phi2")
+		if err != nil {
+			return nil, err
+		}
+		return func(x0 []float64) (nonlinear.Result, error) {
+			return nonlinear.FixedPoint(vector(phi), x0, -1, cfg.params)
+		}, nil
+	}
+
+	strategies := map[string]nonlinear.Strategy{
+		"newton":     nonlinear.FullStep,
+		"linesearch": nonlinear.LineSearch,
+		"dogleg":     nonlinear.TrustRegion,
+		"lm":         nonlinear.LevenbergMarquardt,
+	}
+	strategy, ok := strategies[cfg.method]
+	if !ok {
+		return nil, fmt.Errorf("неизвестный метод %q", cfg.method)
+	}
+
+	F, nodes, err := compile("f1", "f2")
+	if err != nil {
+		return nil, err
+	}
+	jacNodes, err := expr.Jacobian(nodes, vars)
+	if err != nil {
+		return nil, fmt.Errorf("якобиан: %v", err)
+	}
+	jac := make([][]func([]float64) float64, 2)
+	for i := range jacNodes {
+		jac[i] = make([]func([]float64) float64, 2)
+		for j := range jacNodes[i] {
+			jac[i][j], err = expr.Compile(jacNodes[i][j], vars, cfg.constants)
+			if err != nil {
+				return nil, fmt.Errorf("якобиан: %v", err)
+			}
+		}
+	}
+
+	sys := nonlinear.System{
+		F: vector(F),
+		J: func(v []float64) [][]float64 {
+			return [][]float64{
+				{jac[0][0](v), jac[0][1](v)},
+				{jac[1][0](v), jac[1][1](v)},
+			}
+		},
+	}
+	params := cfg.params
+	params.Strategy = strategy
+	return func(x0 []float64) (nonlinear.Result, error) {
+		return nonlinear.Newton(sys, x0, params)
+	}, nil
+}
+
+// computeGrid запускает метод из каждой точки сетки; строки изображения
+// распределяются между горутинами по числу процессоров.
+func computeGrid(cfg Config, solve func([]float64) (nonlinear.Result, error)) [][]pixel {
+	pixels := make([][]pixel, cfg.height)
+	rows := make(chan int)
+	var wg sync.WaitGroup
+	for w := 0; w < runtime.NumCPU(); w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for row := range rows {
+				// Строка 0 — верх изображения, то есть y_max
+				y := cfg.yMax - (cfg.yMax-cfg.yMin)*(float64(row)+0.5)/float64(cfg.height)
+				line := make([]pixel, cfg.width)
+				for col := range line {
+					x := cfg.xMin + (cfg.xMax-cfg.xMin)*(float64(col)+0.5)/float64(cfg.width)
+					res, err := solve([]float64{x, y})
+					line[col] = pixel{x: res.X, iterations: res.Iterations, converged: err == nil && res.Converged}
+				}
+				pixels[row] = line
+			}
+		}()
+	}
+	for row := 0; row < cfg.height; row++ {
+		rows <- row
+	}
+	close(rows)
+	wg.Wait()
+	return pixels
+}
+
+// classify сопоставляет каждой сошедшейся точке номер корня. Корни считаются
+// совпадающими, если расстояние между ними не больше 1000·epsilon.
+func classify(cfg Config, pixels [][]pixel) ([]basin, [][]int) {
+	tol := math.Max(1000*cfg.params.AbsTol, 1e-10)
+	var basins []basin
+	labels := make([][]int, len(pixels))
+	for r, line := range pixels {
+		labels[r] = make([]int, len(line))
+		for c, p := range line {
+			labels[r][c] = -1
+			if !p.converged {
+				continue
+			}
+			k := 0
+			for ; k < len(basins); k++ {
+				if math.Hypot(basins[k].root[0]-p.x[0], basins[k].root[1]-p.x[1]) <= tol {
+					break
+				}
+			}
+			if k == len(basins) {
+				basins = append(basins, basin{root: p.x})
+			}
+			basins[k].pixels++
+			basins[k].iterations += p.iterations
+			labels[r][c] = k
+		}
+	}
+	return basins, labels
+}
+
+// writePNG окрашивает точку цветом её корня; чем больше итераций потребовалось,
+// тем темнее оттенок. Несошедшиеся точки — чёрные.
+func writePNG(filename string, cfg Config, pixels [][]pixel, labels [][]int) error {
+	maxIter := 1
+	for _, line := range pixels {
+		for _, p := range line {
+			if p.converged {
+				maxIter = max(maxIter, p.iterations)
+			}
+		}
+	}
+
+	img := image.NewRGBA(image.Rect(0, 0, cfg.width, cfg.height))
+	for r, line := range pixels {
+		for c, p := range line {
+			k := labels[r][c]
+			if k < 0 {
+				img.SetRGBA(c, r, color.RGBA{0, 0, 0, 255})
+				continue
+			}
+			base := palette[k%len(palette)]
+			shade := 1 - 0.75*math.Log(float64(p.iterations))/math.Log(float64(maxIter)+1)
+			img.SetRGBA(c, r, color.RGBA{
+				R: uint8(float64(base.R) * shade),
+				G: uint8(float64(base.G) * shade),
+				B: uint8(float64(base.B) * shade),
+				A: 255,
+			})
+		}
+	}
+
+	file, err := os.Create(filename)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+	if err := png.Encode(file, img); err != nil {
+		return err
+	}
+	return file.Close()
+}
+
+func writeResults(filename string, cfg Config, pixels [][]pixel, basins []basin) error {
+	file, err := os.Create(filename)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	writer := bufio.NewWriter(file)
+	defer writer.Flush()
+
+	if cfg.method == "iteration" {
+		fmt.Fprintf(writer, "Метод простой итерации: φ1(x, y) = %s, φ2(x, y) = %s\n", cfg.sources["phi1"], cfg.sources["phi2"])
+	} else {
+		fmt.Fprintf(writer, "Метод Ньютона (%s): f1(x, y) = %s, f2(x, y) = %s\n", cfg.method, cfg.sources["f1"], cfg.sources["f2"])
+	}
+	fmt.Fprintf(writer, "Область: [%.4f, %.4f] × [%.4f, %.4f], сетка %d × %d\n",
+		cfg.xMin, cfg.xMax, cfg.yMin, cfg.yMax, cfg.width, cfg.height)
+	fmt.Fprintf(writer, "Точность: %.0e, макс. итераций: %d\n", cfg.params.AbsTol, cfg.params.MaxIter)
+
+	total := cfg.width * cfg.height
+	diverged := total
+	fmt.Fprintln(writer, "\nНайденные корни:")
+	for k, b := range basins {
+		c := palette[k%len(palette)]
+		fmt.Fprintf(writer, "%2d: x = %.8f, y = %.8f — %.2f%% точек, в среднем %.1f итераций, цвет #%02x%02x%02x\n",
+			k+1, b.root[0], b.root[1], 100*float64(b.pixels)/float64(total),
+			float64(b.iterations)/float64(b.pixels), c.R, c.G, c.B)
+		diverged -= b.pixels
+	}
+	fmt.Fprintf(writer, "Не сошлось: %.2f%% точек (чёрный цвет)\n", 100*float64(diverged)/float64(total))
+
+	return nil
+}
@@ -0,0 +1,285 @@
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"math"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/KaiserRed/numeric_methods/internal/nonlinear"
+)
+
+// Задача Брату −Δu = λ·eᵘ в единичном квадрате, u = 0 на границе.
+// Пятиточечная схема на сетке n×n внутренних узлов даёт систему из n²
+// уравнений; уравнения умножены на h².
+type Bratu struct {
+	n      int
+	lambda float64
+}
+
+// Config — размер задачи и настройки метода Ньютона–Крылова
+type Config struct {
+	problem  Bratu
+	params   nonlinear.Params
+	opts     nonlinear.KrylovOptions
+	precond  string
+	forcings []nonlinear.ForcingTerm
+}
+
+var forcingNames = map[string][]nonlinear.ForcingTerm{
+	"const": {nonlinear.ConstantForcing},
+	"ew1":   {nonlinear.EisenstatWalker1},
+	"ew2":   {nonlinear.EisenstatWalker2},
+	"all":   {nonlinear.ConstantForcing, nonlinear.EisenstatWalker1, nonlinear.EisenstatWalker2},
+}
+
+func main() {
+	cfg, err := readInput("input.txt")
+	if err != nil {
+		fmt.Printf("Ошибка чтения: %v\n", err)
+		return
+	}
+
+	p := cfg.problem
+	x0 := make([]float64, p.n*p.n)
+	opts := cfg.opts
+	switch cfg.precond {
+	case "jacobi":
+		opts.Preconditioner = p.jacobi
+	case "sgs":
+		opts.Preconditioner = p.symmetricGaussSeidel
+	}
+
+	runs := make([]run, len(cfg.forcings))
+	for i, forcing := range cfg.forcings {
+		o := opts
+		o.Forcing = forcing
+		start := time.Now()
+		runs[i].res, runs[i].err = nonlinear.NewtonKrylov(p.F, x0, cfg.params, o)
+		runs[i].elapsed = time.Since(start)
+		runs[i].forcing = forcing
+	}
+
+	if err := writeResults("output.txt", cfg, runs); err != nil {
+		fmt.Printf("Ошибка записи: %v\n", err)
+		return
+	}
+
+	fmt.Println("Вычисления завершены. Результаты в output.txt")
+}
+
+type run struct {
+	forcing nonlinear.ForcingTerm
+	res     nonlinear.Result
+	err     error
+	elapsed time.Duration
+}
+
+// Формат файла: строки key=value; n= (узлов по стороне), lambda=,
+// epsilon= (точность по ‖F‖), maxIter=, restart=, max_linear=,
+// eta= (начальное η), eta_max=, forcing=const|ew1|ew2|all,
+// precond=none|jacobi|sgs.
+func readInput(filename string) (Config, error) {
+	file, err := os.Open(filename)
+	if err != nil {
+		return Config{}, err
+	}
+	defer file.Close()
+
+	cfg := Config{
+		problem:  Bratu{n: 100, lambda: 6},
+		params:   nonlinear.Params{FTol: 1e-8, MaxIter: 50},
+		opts:     nonlinear.KrylovOptions{Eta: 0.5, Restart: 30, MaxLinear: 300},
+		precond:  "sgs",
+		forcings: forcingNames["all"],
+	}
+	floats := map[string]*float64{
+		"lambda": &cfg.problem.lambda, "epsilon": &cfg.params.FTol,
+		"eta": &cfg.opts.Eta, "eta_max": &cfg.opts.EtaMax,
+	}
+	ints := map[string]*int{
+		"n": &cfg.problem.n, "maxIter": &cfg.params.MaxIter,
+		"restart": &cfg.opts.Restart, "max_linear": &cfg.opts.MaxLinear,
+	}
+
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		key, value, ok := strings.Cut(line, "=")
+		if !ok {
+			return Config{}, fmt.Errorf("ожидалось: <ключ>=<значение>, получено %q", line)
+		}
+		key, value = strings.TrimSpace(key), strings.TrimSpace(value)
+		switch {
+		case floats[key] != nil:
+			val, err := strconv.ParseFloat(value, 64)
+			if err != nil {
+				return Config{}, fmt.Errorf("ошибка чтения %s: %v", key, err)
+			}
+			*floats[key] = val
+		case ints[key] != nil:
+			val, err := strconv.Atoi(value)
+			if err != nil {
+				return Config{}, fmt.Errorf("ошибка чтения %s: %v", key, err)
+			}
+			*ints[key] = val
+		case key == "forcing":
+			forcings, ok := forcingNames[value]
+			if !ok {
+				return Config{}, fmt.Errorf("неизвестное правило выбора η %q (допустимы const, ew1, ew2, all)", value)
+			}
+			cfg.forcings = forcings
+		case key == "precond":
+			if value != "none" && value != "jacobi" && value != "sgs" {
+				return Config{}, fmt.Errorf("неизвестное предобусловливание %q (допустимы none, jacobi, sgs)", value)
+			}
+			cfg.precond = value
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return Config{}, err
+	}
+	if cfg.problem.n < 1 {
+		return Config{}, fmt.Errorf("число узлов n должно быть положительным")
+	}
+	return cfg, nil
+}
+
+// F(u)_ij = 4u_ij − u_{i−1,j} − u_{i+1,j} − u_{i,j−1} − u_{i,j+1} − h²·λ·e^{u_ij}
+func (p Bratu) F(u []float64) []float64 {
+	n := p.n
+	h := 1 / float64(n+1)
+	c := h * h * p.lambda
+	out := make([]float64, len(u))
+	at := func(i, j int) float64 {
+		if i < 0 || j < 0 || i >= n || j >= n {
+			return 0
+		}
+		return u[i*n+j]
+	}
+	for i := 0; i < n; i++ {
+		for j := 0; j < n; j++ {
+			k := i*n + j
+			out[k] = 4*u[k] - at(i-1, j) - at(i+1, j) - at(i, j-1) - at(i, j+1) - c*math.Exp(u[k])
+		}
+	}
+	return out
+}
+
+// diagonal — диагональ матрицы Якоби: 4 − h²·λ·e^{u_ij}
+func (p Bratu) diagonal(u []float64) []float64 {
+	h := 1 / float64(p.n+1)
+	c := h * h * p.lambda
+	d := make([]float64, len(u))
+	for k := range u {
+		d[k] = 4 - c*math.Exp(u[k])
+	}
+	return d
+}
+
+// jacobi — предобусловливание диагональю матрицы Якоби.
+func (p Bratu) jacobi(u, _ []float64) func([]float64) []float64 {
+	d := p.diagonal(u)
+	return func(v []float64) []float64 {
+		z := make([]float64, len(v))
+		for k := range v {
+			z[k] = v[k] / d[k]
+		}
+		return z
+	}
+}
+
+// symmetricGaussSeidel — один симметричный проход Гаусса–Зейделя для
+// матрицы Якоби: M = (D + L)·D⁻¹·(D + U).
+func (p Bratu) symmetricGaussSeidel(u, _ []float64) func([]float64) []float64 {
+	n := p.n
+	d := p.diagonal(u)
+	return func(v []float64) []float64 {
+		z := make([]float64, len(v))
+		// Прямой ход: (D + L)·y = v, внедиагональные элементы равны −1
+		for i := 0; i < n; i++ {
+			for j := 0; j < n; j++ {
+				k := i*n + j
+				s := v[k]
+				if i > 0 {
+					s += z[k-n]
+				}
+				if j > 0 {
+					s += z[k-1]
+				}
+				z[k] = s / d[k]
+			}
+		}
+		// Обратный ход: (D + U)·z = D·y
+		for i := n - 1; i >= 0; i-- {
+			for j := n - 1; j >= 0; j-- {
+				k := i*n + j
+				s := d[k] * z[k]
+				if i < n-1 {
+					s += z[k+n]
+				}
+				if j < n-1 {
+					s += z[k+1]
+				}
+				z[k] = s / d[k]
+			}
+		}
+		return z
+	}
+}
+
+func writeResults(filename string, cfg Config, runs []run) error {
+	file, err := os.Create(filename)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	writer := bufio.NewWriter(file)
+	defer writer.Flush()
+
+	p := cfg.problem
+	fmt.Fprintf(writer, "Задача Брату −Δu = λ·eᵘ, λ = %g, сетка %d×%d (неизвестных: %d)\n", p.lambda, p.n, p.n, p.n*p.n)
+	fmt.Fprintf(writer, "Метод Ньютона–Крылова: J·v конечными разностями, GMRES(%d), до %d внутренних итераций на шаг\n",
+		cfg.opts.Restart, cfg.opts.MaxLinear)
+	fmt.Fprintf(writer, "Предобусловливание: %s\n", cfg.precond)
+	fmt.Fprintf(writer, "Точность по ‖F‖: %.0e, макс. итераций: %d\n", cfg.params.FTol, cfg.params.MaxIter)
+
+	for _, r := range runs {
+		fmt.Fprintf(writer, "\n--- η: %v ---\n", r.forcing)
+		if r.err != nil {
+			fmt.Fprintf(writer, "Ошибка: %v\n", r.err)
+		}
+		fmt.Fprintf(writer, "%4s %14s %10s %10s %8s\n", "k", "‖F‖", "η", "λ шага", "GMRES")
+		for i, it := range r.res.History {
+			fmt.Fprintf(writer, "%4d %14.6e %10.3e %10.4f %8d\n", i+1, it.FNorm, it.Forcing, it.Damping, it.LinearIterations)
+		}
+		status := "достигнута"
+		if !r.res.Converged {
+			status = "не достигнута"
+		}
+		fmt.Fprintf(writer, "Сходимость: %s\n", status)
+		fmt.Fprintf(writer, "Внешних итераций: %d, внутренних: %d, вычислений F: %d, время: %v\n",
+			r.res.Iterations, r.res.LinearIterations, r.res.FEvals, r.elapsed.Round(time.Millisecond))
+		if len(r.res.X) > 0 {
+			maxU := 0.0
+			for _, u := range r.res.X {
+				maxU = math.Max(maxU, u)
+			}
+			fmt.Fprintf(writer, "max u = %.8f\n", maxU)
+		}
+	}
+
+	fmt.Fprintln(writer, "\nСводка:")
+	fmt.Fprintf(writer, "%-30s %10s %10s %12s\n", "η", "Внешних", "Внутренних", "Вычисл. F")
+	for _, r := range runs {
+		fmt.Fprintf(writer, "%-30v %10d %10d %12d\n", r.forcing, r.res.Iterations, r.res.LinearIterations, r.res.FEvals)
+	}
+	return nil
+}
@@ -3,7 +3,10 @@ package main
 import (
 	"fmt"
 	"math"
+	"os"
 	"strings"
+
+	"github.com/KaiserRed/numeric_methods/internal/interpolation"
 )
 
 func main() {
@@ -34,81 +37,86 @@ func main() {
 }
 
 func processInterpolation(xi, yi []float64, xStar, trueValue float64) {
-	lagrangeResult := lagrangeInterpolation(xStar, xi, yi)
+	lagrange, err := interpolation.NewLagrange(xi, yi)
+	if err != nil {
+		fmt.Printf("Ошибка: %v\n", err)
+		return
+	}
+	lagrangeResult := lagrange.Eval(xStar)
 	lagrangeError := math.Abs(lagrangeResult - trueValue)
 
-	printLagrangeTable(xi, yi, xStar)
+	printLagrangeTable(xi, yi, lagrange.Weights(), xStar)
 	fmt.Println("\nМетод Лагранжа")
 	fmt.Println(formatLagrangePolynomial(xi, yi))
 	fmt.Printf("L(%.1f) = %.6f\n", xStar, lagrangeResult)
 	fmt.Printf("Точное значение f(%.1f) = %.6f\n", xStar, trueValue)
 	fmt.Printf("Абсолютная погрешность: %.6f\n", lagrangeError)
 
-	newtonResult, dividedDiffs := newtonInterpolation(xStar, xi, yi)
+	newton, err := interpolation.NewNewton(xi, yi)
+	if err != nil {
+		fmt.Printf("Ошибка: %v\n", err)
+		return
+	}
+	newtonResult := newton.Eval(xStar)
 	newtonError := math.Abs(newtonResult - trueValue)
 
-	printDividedDifferences(xi, yi, dividedDiffs)
+	printDividedDifferences(xi, newton.Table())
 	fmt.Println("\nМетод Ньютона:")
-	fmt.Println(formatNewtonPolynomial(xi, dividedDiffs))
+	fmt.Println(formatNewtonPolynomial(xi, newton.Coefficients()))
 	fmt.Printf("P(%.1f) = %.6f\n", xStar, newtonResult)
 	fmt.Printf("Точное значение f(%.1f) = %.6f\n", xStar, trueValue)
 	fmt.Printf("Абсолютная погрешность: %.6f\n", newtonError)
-}
 
-func lagrangeInterpolation(x float64, xi, yi []float64) float64 {
-	var result float64
-	n := len(xi)
-	for i := 0; i < n; i++ {
-		term := yi[i]
-		for j := 0; j < n; j++ {
-			if j != i {
-				term *= (x - xi[j]) / (xi[i] - xi[j])
-			}
-		}
-		result += term
-	}
-	return result
-}
+	trueDerivative := -1 / (xStar * xStar)
+	fmt.Printf("\nПроизводная: L'(%.1f) = %.6f, P'(%.1f) = %.6f, f'(%.1f) = %.6f\n",
+		xStar, lagrange.Derivative(xStar), xStar, newton.Derivative(xStar), xStar, trueDerivative)
 
-func newtonInterpolation(x float64, xi, yi []float64) (float64, [][]float64) {
-	n := len(xi)
+	processHermite(xi, yi, xStar, trueValue)
+}
 
-	f := make([][]float64, n)
-	for i := range f {
-		f[i] = make([]float64, n)
-		f[i][0] = yi[i]
+// processHermite добавляет к значениям 1/x производные −1/x² и строит
+// многочлен Эрмита и кусочно-кубический эрмитов интерполянт.
+func processHermite(xi, yi []float64, xStar, trueValue float64) {
+	nodes := make([]interpolation.HermiteNode, len(xi))
+	slopes := make([]float64, len(xi))
+	for i := range xi {
+		slopes[i] = -1 / (xi[i] * xi[i])
+		nodes[i] = interpolation.HermiteNode{X: xi[i], Values: []float64{yi[i], slopes[i]}}
 	}
 
-	for j := 1; j < n; j++ {
-		for i := 0; i < n-j; i++ {
-			f[i][j] = (f[i+1][j-1] - f[i][j-1]) / (xi[i+j] - xi[i])
-		}
+	hermite, err := interpolation.NewHermite(nodes)
+	if err != nil {
+		fmt.Printf("Ошибка: %v\n", err)
+		return
 	}
-
-	result := f[0][0]
-	product := 1.0
-	for j := 1; j < n; j++ {
-		product *= (x - xi[j-1])
-		result += product * f[0][j]
+	fmt.Println("\nМетод Эрмита (узлы с производными, таблица разделённых разностей):")
+	if err := interpolation.WriteTable(os.Stdout, hermite.Nodes(), hermite.Table()); err != nil {
+		fmt.Printf("Ошибка: %v\n", err)
+		return
 	}
-
-	return result, f
+	hermiteResult := hermite.Eval(xStar)
+	fmt.Printf("H(%.1f) = %.6f (степень %d)\n", xStar, hermiteResult, hermite.Degree())
+	fmt.Printf("Абсолютная погрешность: %.6f\n", math.Abs(hermiteResult-trueValue))
+
+	piecewise, err := interpolation.NewPiecewiseHermite(xi, yi, slopes)
+	if err != nil {
+		fmt.Printf("Ошибка: %v\n", err)
+		return
+	}
+	piecewiseResult := piecewise.Eval(xStar)
+	fmt.Println("\nКусочно-кубический эрмитов интерполянт:")
+	fmt.Printf("S(%.1f) = %.6f\n", xStar, piecewiseResult)
+	fmt.Printf("Абсолютная погрешность: %.6f\n", math.Abs(piecewiseResult-trueValue))
 }
 
-func printLagrangeTable(xi, yi []float64, xStar float64) {
+func printLagrangeTable(xi, yi, weights []float64, xStar float64) {
 	fmt.Println("\nМетод Лагранжа:")
 	fmt.Println(" i    x_i      f_i    ω₄'(x_i)  f_i/ω₄'(x_i)  X*-x_i ")
 
-	// Вычисляем ω₄'(x_i)
+	// Барицентрические веса: w_i = 1/ω₄'(x_i)
 	omegaPrimes := make([]float64, len(xi))
-	for i := range xi {
-		prod := 1.0
-		for j := range xi {
-			if j != i {
-				prod *= (xi[i] - xi[j])
-			}
-		}
-		omegaPrimes[i] = prod
+	for i, w := range weights {
+		omegaPrimes[i] = 1 / w
 	}
 
 	for i := range xi {
@@ -117,7 +125,7 @@ func printLagrangeTable(xi, yi []float64, xStar float64) {
 	}
 }
 
-func printDividedDifferences(xi, yi []float64, f [][]float64) {
+func printDividedDifferences(xi []float64, f [][]float64) {
 	fmt.Println("\nМетод Ньютона (таблица разделённых разностей):")
 	fmt.Println(" i    x_i      f[x_i]    f[x_i,x_i+1]  f[x_i,x_i+1,x_i+2]  f[x0,x1,x2,x3] ")
 
@@ -167,14 +175,14 @@ func formatLagrangePolynomial(xi, yi []float64) string {
 	return buf.String()
 }
 
-func formatNewtonPolynomial(xi []float64, diffs [][]float64) string {
+func formatNewtonPolynomial(xi []float64, coeffs []float64) string {
 	var buf strings.Builder
 	buf.WriteString("P(x) = ")
-	buf.WriteString(fmt.Sprintf("%.6f", diffs[0][0])) // f[x0]
+	buf.WriteString(fmt.Sprintf("%.6f", coeffs[0])) // f[x0]
 
-	for j := 1; j < len(diffs[0]); j++ {
+	for j := 1; j < len(coeffs); j++ {
 		buf.WriteString(" + ")
-		buf.WriteString(fmt.Sprintf("%.6f", diffs[0][j])) // f[x0,x1,...,xj]
+		buf.WriteString(fmt.Sprintf("%.6f", coeffs[j])) // f[x0,x1,...,xj]
 		for k := 0; k < j; k++ {
 			buf.WriteString(fmt.Sprintf("*(x - %.4f)", xi[k]))
 		}
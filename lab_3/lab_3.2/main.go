@@ -3,203 +3,95 @@ package main
 import (
 	"fmt"
 	"math"
-)
+	"os"
 
-type Spline struct {
-	xi []float64
-	fi []float64
-	ai []float64
-	bi []float64
-	ci []float64
-	di []float64
-}
+	"github.com/KaiserRed/numeric_methods/internal/spline"
+)
 
 func main() {
 	xi := []float64{0.1, 0.5, 0.9, 1.3, 1.7}
 	fi := []float64{10.0, 2.0, 1.1111, 0.76923, 0.58824}
 	xStar := 0.8
 
-	spline := BuildNaturalSpline(xi, fi)
+	s, err := spline.BuildNaturalSpline(xi, fi)
+	if err != nil {
+		fmt.Println("Ошибка:", err)
+		os.Exit(1)
+	}
 
-	splineValue := spline.Evaluate(xStar)
+	splineValue := s.Evaluate(xStar)
 	trueValue := 1 / xStar
 	error := math.Abs(splineValue - trueValue)
 
-	PrintSplineInfo(spline, xi)
+	PrintSplineInfo(s)
 	fmt.Printf("\nТочка интерполяции X* = %.1f\n", xStar)
 	fmt.Printf("Значение сплайна: %.5f\n", splineValue)
 	fmt.Printf("Точное значение: %.5f\n", trueValue)
 	fmt.Printf("Погрешность: %.5f\n", error)
-	fmt.Println("Проверки")
-	if !spline.CheckNodeValues() {
-		fmt.Println("Ошибка: сплайн не проходит через узлы")
-	}
-	fmt.Println("Значения в узлах: Пройдена")
-
-	if !spline.CheckBoundaryConditions() {
-		fmt.Println("Ошибка: нарушены граничные условия")
-	}
-	fmt.Println("Граничный условий: Пройдена")
-
-}
-
-func BuildNaturalSpline(xi, fi []float64) Spline {
-	n := len(xi)
-	if n < 3 {
-		panic("Для построения сплайна нужно минимум 3 точки")
-	}
-
-	h := calculateSteps(xi)
-	A, b := buildTridiagonalSystem(xi, fi, h)
-	ci := solveTridiagonal(A, b)
-	ci = append([]float64{0}, ci...)
-	ci = append(ci, 0)
-
-	ai, bi, di := calculateSplineCoefficients(xi, fi, h, ci)
-
-	return Spline{xi, fi, ai, bi, ci, di}
-}
-
-func calculateSteps(xi []float64) []float64 {
-	h := make([]float64, len(xi)-1)
-	for i := 0; i < len(h); i++ {
-		h[i] = xi[i+1] - xi[i]
-	}
-	return h
-}
-
-func buildTridiagonalSystem(xi, fi, h []float64) ([][]float64, []float64) {
-	n := len(xi)
-	A := make([][]float64, n-2)
-	for i := range A {
-		A[i] = make([]float64, n-2)
-	}
-
-	A[0][0] = 2 * (h[0] + h[1])
-	A[0][1] = h[1]
-	for i := 1; i < n-3; i++ {
-		A[i][i-1] = h[i]
-		A[i][i] = 2 * (h[i] + h[i+1])
-		A[i][i+1] = h[i+1]
-	}
-	if n > 3 {
-		A[n-3][n-4] = h[n-3]
-		A[n-3][n-3] = 2 * (h[n-3] + h[n-2])
-	}
-
-	b := make([]float64, n-2)
-	for i := 0; i < n-2; i++ {
-		b[i] = 3 * ((fi[i+2]-fi[i+1])/h[i+1] - (fi[i+1]-fi[i])/h[i])
+	fmt.Printf("S'(X*) = %.5f, f'(X*) = %.5f\n", s.Derivative(xStar), -1/(xStar*xStar))
+	fmt.Printf("S''(X*) = %.5f, f''(X*) = %.5f\n", s.SecondDerivative(xStar), 2/(xStar*xStar*xStar))
+	a, b := s.Domain()
+	if integral, err := s.Integrate(a, b); err == nil {
+		fmt.Printf("Интеграл сплайна по [%.1f, %.1f]: %.5f, ln(x_n/x_0) = %.5f\n", a, b, integral, math.Log(b/a))
 	}
+	fmt.Println("Проверки")
+	printCheck("Значения в узлах", s.CheckNodeValues())
+	printCheck("Граничный условий", s.CheckBoundaryConditions())
 
-	return A, b
+	compareBoundaries(xi, fi, xStar)
 }
 
-func solveTridiagonal(A [][]float64, b []float64) []float64 {
-	n := len(b)
-	if n == 0 {
-		return nil
+func printCheck(name string, err error) {
+	if err != nil {
+		fmt.Printf("%s: Ошибка: %v\n", name, err)
+		return
 	}
-
-	alpha := make([]float64, n)
-	beta := make([]float64, n)
-
-	alpha[0] = -A[0][1] / A[0][0]
-	beta[0] = b[0] / A[0][0]
-
-	for i := 1; i < n-1; i++ {
-		denominator := A[i][i] + A[i][i-1]*alpha[i-1]
-		alpha[i] = -A[i][i+1] / denominator
-		beta[i] = (b[i] - A[i][i-1]*beta[i-1]) / denominator
-	}
-
-	x := make([]float64, n)
-	x[n-1] = (b[n-1] - A[n-1][n-2]*beta[n-2]) /
-		(A[n-1][n-1] + A[n-1][n-2]*alpha[n-2])
-
-	for i := n - 2; i >= 0; i-- {
-		x[i] = alpha[i]*x[i+1] + beta[i]
-	}
-
-	return x
+	fmt.Printf("%s: Пройдена\n", name)
 }
 
-func calculateSplineCoefficients(xi, fi, h, ci []float64) ([]float64, []float64, []float64) {
-	n := len(xi)
-	ai := make([]float64, n-1)
-	bi := make([]float64, n-1)
-	di := make([]float64, n-1)
-
-	for i := 0; i < n-1; i++ {
-		ai[i] = fi[i]
-		if i < n-2 {
-			bi[i] = (fi[i+1]-fi[i])/h[i] - h[i]*(2*ci[i]+ci[i+1])/3
-			di[i] = (ci[i+1] - ci[i]) / (3 * h[i])
-		} else {
-			bi[i] = (fi[i+1]-fi[i])/h[i] - h[i]*2*ci[i]/3
-			di[i] = -ci[i] / (3 * h[i])
+// compareBoundaries строит сплайны с разными граничными условиями по тем же
+// узлам. Производные на концах берутся у f(x) = 1/x; периодические условия
+// к этим данным неприменимы.
+func compareBoundaries(xi, fi []float64, xStar float64) {
+	a, b := xi[0], xi[len(xi)-1]
+	boundaries := []spline.Boundary{
+		{Kind: spline.Natural},
+		{Kind: spline.Clamped, Left: -1 / (a * a), Right: -1 / (b * b)},
+		{Kind: spline.SecondDerivative, Left: 2 / (a * a * a), Right: 2 / (b * b * b)},
+		{Kind: spline.NotAKnot},
+	}
+
+	fmt.Println("\nСравнение граничных условий в точке X*")
+	fmt.Printf("%-28s %12s %12s  %s\n", "Условия", "S(X*)", "Погрешность", "Проверка")
+	for _, bc := range boundaries {
+		s, err := spline.Build(xi, fi, bc)
+		if err != nil {
+			fmt.Printf("%-28s Ошибка: %v\n", bc.Kind, err)
+			continue
 		}
-	}
-
-	return ai, bi, di
-}
-
-func (s *Spline) Evaluate(x float64) float64 {
-	interval := s.findInterval(x)
-	dx := x - s.xi[interval]
-	return s.ai[interval] + s.bi[interval]*dx + s.ci[interval]*dx*dx + s.di[interval]*dx*dx*dx
-}
-
-func (s *Spline) findInterval(x float64) int {
-	for i := 0; i < len(s.xi)-1; i++ {
-		if x >= s.xi[i] && x <= s.xi[i+1] {
-			return i
+		value := s.Evaluate(xStar)
+		status := "Пройдена"
+		if err := s.CheckBoundaryConditions(); err != nil {
+			status = "Ошибка: " + err.Error()
 		}
+		fmt.Printf("%-28s %12.5f %12.5f  %s\n", bc.Kind, value, math.Abs(value-1/xStar), status)
 	}
-	return len(s.xi) - 2
 }
 
-func PrintSplineInfo(s Spline, xi []float64) {
+func PrintSplineInfo(s *spline.Spline) {
+	xi, fi := s.Nodes()
+	a, b, c, d := s.Coefficients()
 	fmt.Println("Построение кубического сплайна с естественными граничными условиями")
 	fmt.Println("Узлы интерполяции:")
 	fmt.Println("i\tx_i\t\tf_i")
 	for i := range xi {
-		fmt.Printf("%d\t%.4f\t%.5f\n", i, xi[i], s.fi[i])
+		fmt.Printf("%d\t%.4f\t%.5f\n", i, xi[i], fi[i])
 	}
 
 	fmt.Println("\nКоэффициенты сплайна:")
 	fmt.Println("i  [x_i-1, x_i] \ta_i \tb_i \tc_i \td_i")
-	for i := 1; i < len(s.ai)+1; i++ {
-		if i-1 < len(s.ai) {
-			fmt.Printf("%d  [%.1f,%.1f]  %.5f  %.5f  %.5f  %.5f\n",
-				i, xi[i-1], xi[i], s.ai[i-1], s.bi[i-1], s.ci[i-1], s.di[i-1])
-		}
-	}
-}
-
-func (s *Spline) CheckNodeValues() bool {
-	for i, x := range s.xi {
-		// Вычисляем значение сплайна в узле x_i
-		val := s.Evaluate(x)
-		// Сравниваем с заданным значением f_i
-		if math.Abs(val-s.fi[i]) > 1e-9 {
-			fmt.Printf("Ошибка в узле %d: x=%.2f, сплайн=%.6f, f_i=%.6f\n",
-				i, x, val, s.fi[i])
-			return false
-		}
-	}
-	return true
-}
-
-func (s *Spline) CheckBoundaryConditions() bool {
-	n := len(s.xi)
-	d2_0 := 2*s.ci[0] + 6*s.di[0]*(s.xi[0]-s.xi[0])
-	d2_n := 2*s.ci[n-2] + 6*s.di[n-2]*(s.xi[n-1]-s.xi[n-2])
-
-	if math.Abs(d2_0) > 1e-9 || math.Abs(d2_n) > 1e-9 {
-		fmt.Printf("Нарушены граничные условия: d2_0=%.6f, d2_n=%.6f\n",
-			d2_0, d2_n)
-		return false
+	for i := 1; i < len(a)+1; i++ {
+		fmt.Printf("%d  [%.1f,%.1f]  %.5f  %.5f  %.5f  %.5f\n",
+			i, xi[i-1], xi[i], a[i-1], b[i-1], c[i-1], d[i-1])
 	}
-	return true
 }
@@ -3,6 +3,8 @@ package main
 import (
 	"fmt"
 	"math"
+
+	"github.com/KaiserRed/numeric_methods/internal/svd_decompose"
 )
 
 func main() {
@@ -79,6 +81,8 @@ func leastSquares(x, y []float64, degree int) ([]float64, float64) {
 		fmt.Printf("%.2fa + %.2fb + %.2fc = %.5f\n", A[2][0], A[2][1], A[2][2], b[2])
 	}
 
+	printNormalSystemDiagnostics(A)
+
 	coeffs := gauss(A, b)
 
 	fmt.Println("\nРешение системы:")
@@ -104,6 +108,32 @@ func leastSquares(x, y []float64, degree int) ([]float64, float64) {
 	return coeffs, err
 }
 
+func printNormalSystemDiagnostics(A [][]float64) {
+	_, sigma, _, err := svd_decompose.SVDDecomposition(A)
+	if err != nil {
+		fmt.Printf("\nОшибка SVD нормальной матрицы: %v\n", err)
+		return
+	}
+
+	m := len(A)
+	tol := svd_decompose.DefaultTolerance(m, m, sigma)
+	rank := svd_decompose.Rank(sigma, tol)
+	cond := svd_decompose.ConditionNumber(sigma)
+
+	fmt.Println("\nДиагностика нормальной системы (SVD):")
+	fmt.Print("Сингулярные числа:")
+	for _, s := range sigma {
+		fmt.Printf(" %.6e", s)
+	}
+	fmt.Printf("\nЧисленный ранг: %d из %d\n", rank, m)
+	fmt.Printf("Число обусловленности: %.6e\n", cond)
+	if rank < m {
+		fmt.Println("Внимание: нормальная система вырождена, решение неединственно")
+	} else if cond*svd_decompose.MachineEpsilon > 1e-6 {
+		fmt.Println("Внимание: нормальная система плохо обусловлена")
+	}
+}
+
 func gauss(A [][]float64, b []float64) []float64 {
 	n := len(b)
 	x := make([]float64, n)
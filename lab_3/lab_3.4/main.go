@@ -2,8 +2,16 @@ package main
 
 import (
 	"fmt"
+	"math"
+
+	"github.com/KaiserRed/numeric_methods/internal/autodiff"
 )
 
+// Табличная функция y = x + 1/x, записанная над гипердуальными числами
+func f(x autodiff.HyperDual) autodiff.HyperDual {
+	return autodiff.HAdd(x, autodiff.HDiv(autodiff.HyperConst(1), x))
+}
+
 func main() {
 	x := []float64{1.0, 1.5, 2.0, 2.5, 3.0}
 	y := []float64{2.0, 2.1667, 2.5, 2.9, 3.3333}
@@ -65,4 +73,12 @@ func main() {
 	fmt.Println("Проверка второй производной через разности первых производных:")
 	fmt.Printf("Формула: y''(X*) ≈ (y'(x+h) - y'(x-h)) / (2h) = (%.6f - %.6f) / (2*%.2f) = %.6f\n",
 		fpxh, fmxh, h, secondDiffCheck)
+
+	_, exactFirst, exactSecond := autodiff.SecondDerivative(f, Xstar)
+	fmt.Println("\nСравнение с точными производными (автоматическое дифференцирование y = x + 1/x):")
+	fmt.Printf("y'(X*) = %.6f, y''(X*) = %.6f\n", exactFirst, exactSecond)
+	fmt.Printf("Погрешность левосторонней:  %.6f\n", math.Abs(leftDiff-exactFirst))
+	fmt.Printf("Погрешность правосторонней: %.6f\n", math.Abs(rightDiff-exactFirst))
+	fmt.Printf("Погрешность центральной:    %.6f\n", math.Abs(centerDiff-exactFirst))
+	fmt.Printf("Погрешность второй производной: %.6f\n", math.Abs(secondDiff-exactSecond))
 }
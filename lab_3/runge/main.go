@@ -0,0 +1,309 @@
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"math"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/KaiserRed/numeric_methods/internal/expr"
+	"github.com/KaiserRed/numeric_methods/internal/interpolation"
+)
+
+// Функция Рунге: на равноотстоящих узлах интерполяция расходится у концов
+const defaultF = "1/(1+25*x^2)"
+
+// Config — функция, отрезок, степени многочленов и число точек проверки
+type Config struct {
+	fSrc      string
+	constants map[string]float64
+	a, b      float64
+	degrees   []int
+	bounds    []float64
+	samples   int
+}
+
+// NodeSet — способ расстановки n узлов на [a, b]
+type NodeSet struct {
+	name  string
+	nodes func(a, b float64, n int) ([]float64, error)
+}
+
+var nodeSets = []NodeSet{
+	{"Равноотстоящие", interpolation.Equispaced},
+	{"Чебышёв I рода", interpolation.ChebyshevFirstKind},
+	{"Чебышёв II рода", interpolation.ChebyshevSecondKind},
+}
+
+// Report — погрешности интерполяции для одного набора узлов
+type Report struct {
+	set        string
+	actual     float64
+	actualAt   float64
+	omega      float64
+	apriori    float64
+	aposterior float64
+	err        error
+}
+
+func main() {
+	cfg, err := readInput("input.txt")
+	if err != nil {
+		fmt.Printf("Ошибка чтения: %v\n", err)
+		return
+	}
+
+	f, err := expr.ParseFunc1(cfg.fSrc, "x", cfg.constants)
+	if err != nil {
+		fmt.Printf("Ошибка разбора функции: %v\n", err)
+		return
+	}
+
+	grid := make([]float64, cfg.samples)
+	for i := range grid {
+		grid[i] = cfg.a + (cfg.b-cfg.a)*float64(i)/float64(cfg.samples-1)
+	}
+
+	results := make([][]Report, len(cfg.degrees))
+	bounds := make([]float64, len(cfg.degrees))
+	boundErrs := make([]error, len(cfg.degrees))
+	var last []interpolation.Interpolator
+	for i, n := range cfg.degrees {
+		if cfg.bounds != nil {
+			bounds[i] = cfg.bounds[i]
+		} else {
+			bounds[i], boundErrs[i] = interpolation.EstimateDerivativeBound(f, cfg.a, cfg.b, n+1)
+		}
+		last = last[:0]
+		for _, set := range nodeSets {
+			r, p := analyze(f, set, cfg, n, bounds[i], boundErrs[i] == nil, grid)
+			results[i] = append(results[i], r)
+			last = append(last, p)
+		}
+	}
+
+	if err := writeCSV("runge.csv", f, grid, last); err != nil {
+		fmt.Printf("Ошибка записи CSV: %v\n", err)
+		return
+	}
+	if err := writeResults("output.txt", cfg, bounds, boundErrs, results); err != nil {
+		fmt.Printf("Ошибка записи: %v\n", err)
+		return
+	}
+
+	fmt.Println("Вычисления завершены. Результаты в output.txt, значения на сетке в runge.csv")
+}
+
+// analyze строит многочлен степени n по узлам set и сравнивает
+// фактическую погрешность с априорной и апостериорной оценками.
+// Дополнительный узел апостериорной оценки — точка максимума |ω|.
+func analyze(f func(float64) float64, set NodeSet, cfg Config, n int, M float64, haveM bool, grid []float64) (Report, interpolation.Interpolator) {
+	r := Report{set: set.name, apriori: math.NaN()}
+	nodes, err := set.nodes(cfg.a, cfg.b, n+1)
+	if err != nil {
+		r.err = err
+		return r, nil
+	}
+	values := make([]float64, len(nodes))
+	for i, x := range nodes {
+		values[i] = f(x)
+	}
+	p, err := interpolation.NewNewton(nodes, values)
+	if err != nil {
+		r.err = err
+		return r, nil
+	}
+
+	var extra float64
+	r.omega, extra = interpolation.MaxAbsOmega(nodes, cfg.a, cfg.b, cfg.samples)
+	if haveM {
+		r.apriori = interpolation.APrioriBound(nodes, extra, M)
+	}
+	approx := p.EvalMany(grid)
+	for i, x := range grid {
+		if e := math.Abs(f(x) - approx[i]); e > r.actual {
+			r.actual, r.actualAt = e, x
+		}
+		est, err := p.ErrorEstimate(x, extra, f(extra))
+		if err != nil {
+			r.err = err
+			return r, p
+		}
+		r.aposterior = math.Max(r.aposterior, math.Abs(est))
+	}
+	return r, p
+}
+
+// Формат файла: строки key=value; f= (по умолчанию функция Рунге),
+// param <имя>=<значение>, a=, b=, n=5,10,15,20 (степени многочленов),
+// M= (оценки max|f⁽ⁿ⁺¹⁾| через запятую для каждой степени; если не заданы,
+// оцениваются разделёнными разностями), samples= (точек проверки).
+func readInput(filename string) (Config, error) {
+	file, err := os.Open(filename)
+	if err != nil {
+		return Config{}, err
+	}
+	defer file.Close()
+
+	cfg := Config{
+		fSrc:      defaultF,
+		constants: map[string]float64{},
+		a:         -1, b: 1,
+		degrees: []int{5, 10, 15, 20},
+		samples: 2001,
+	}
+
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		if strings.HasPrefix(line, "param ") {
+			name, value, ok := strings.Cut(strings.TrimPrefix(line, "param "), "=")
+			if !ok {
+				return Config{}, fmt.Errorf("ожидалось: param <имя>=<значение>")
+			}
+			val, err := strconv.ParseFloat(strings.TrimSpace(value), 64)
+			if err != nil {
+				return Config{}, fmt.Errorf("ошибка чтения параметра %s: %v", name, err)
+			}
+			cfg.constants[strings.TrimSpace(name)] = val
+			continue
+		}
+
+		key, value, ok := strings.Cut(line, "=")
+		if !ok {
+			return Config{}, fmt.Errorf("ожидалось: <ключ>=<значение>, получено %q", line)
+		}
+		key, value = strings.TrimSpace(key), strings.TrimSpace(value)
+		switch key {
+		case "f":
+			cfg.fSrc = value
+		case "a", "b":
+			val, err := strconv.ParseFloat(value, 64)
+			if err != nil {
+				return Config{}, fmt.Errorf("ошибка чтения %s: %v", key, err)
+			}
+			if key == "a" {
+				cfg.a = val
+			} else {
+				cfg.b = val
+			}
+		case "n":
+			cfg.degrees = cfg.degrees[:0]
+			for _, field := range strings.Split(value, ",") {
+				val, err := strconv.Atoi(strings.TrimSpace(field))
+				if err != nil || val < 1 {
+					return Config{}, fmt.Errorf("ошибка чтения n: ожидались натуральные степени через запятую")
+				}
+				cfg.degrees = append(cfg.degrees, val)
+			}
+		case "M":
+			cfg.bounds = nil
+			for _, field := range strings.Split(value, ",") {
+				val, err := strconv.ParseFloat(strings.TrimSpace(field), 64)
+				if err != nil {
+					return Config{}, fmt.Errorf("ошибка чтения M: %v", err)
+				}
+				cfg.bounds = append(cfg.bounds, val)
+			}
+		case "samples":
+			val, err := strconv.Atoi(value)
+			if err != nil || val < 2 {
+				return Config{}, fmt.Errorf("ошибка чтения samples: ожидалось число не меньше 2")
+			}
+			cfg.samples = val
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return Config{}, err
+	}
+	if cfg.a >= cfg.b {
+		return Config{}, fmt.Errorf("левая граница должна быть меньше правой")
+	}
+	if cfg.bounds != nil && len(cfg.bounds) != len(cfg.degrees) {
+		return Config{}, fmt.Errorf("число значений M (%d) не совпадает с числом степеней n (%d)", len(cfg.bounds), len(cfg.degrees))
+	}
+	return cfg, nil
+}
+
+// writeCSV сохраняет f и многочлены наибольшей степени на сетке для графика.
+func writeCSV(filename string, f func(float64) float64, grid []float64, polys []interpolation.Interpolator) error {
+	file, err := os.Create(filename)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	writer := bufio.NewWriter(file)
+	defer writer.Flush()
+
+	fmt.Fprint(writer, "x,f")
+	for i := range polys {
+		fmt.Fprintf(writer, ",p%d", i+1)
+	}
+	fmt.Fprintln(writer)
+	values := make([][]float64, len(polys))
+	for i, p := range polys {
+		if p != nil {
+			values[i] = p.EvalMany(grid)
+		}
+	}
+	for j, x := range grid {
+		fmt.Fprintf(writer, "%.10g,%.10g", x, f(x))
+		for i := range polys {
+			if values[i] == nil {
+				fmt.Fprint(writer, ",")
+			} else {
+				fmt.Fprintf(writer, ",%.10g", values[i][j])
+			}
+		}
+		fmt.Fprintln(writer)
+	}
+	return nil
+}
+
+func writeResults(filename string, cfg Config, bounds []float64, boundErrs []error, results [][]Report) error {
+	file, err := os.Create(filename)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	writer := bufio.NewWriter(file)
+	defer writer.Flush()
+
+	fmt.Fprintf(writer, "Функция: f(x) = %s на [%g, %g]\n", cfg.fSrc, cfg.a, cfg.b)
+	fmt.Fprintf(writer, "Точек проверки: %d\n", cfg.samples)
+	fmt.Fprintln(writer, "Априорная оценка: M·max|ω(x)|/(n+1)!, M ≈ max|f⁽ⁿ⁺¹⁾|")
+	fmt.Fprintln(writer, "Апостериорная оценка: max|f[x₀, …, xₙ, x*]·ω(x)|, x* — точка максимума |ω|")
+
+	for i, n := range cfg.degrees {
+		fmt.Fprintf(writer, "\n--- Степень n = %d (узлов: %d) ---\n", n, n+1)
+		switch {
+		case boundErrs[i] != nil:
+			fmt.Fprintf(writer, "M: %v\n", boundErrs[i])
+		case cfg.bounds != nil:
+			fmt.Fprintf(writer, "M = %.4e (задано)\n", bounds[i])
+		default:
+			fmt.Fprintf(writer, "M ≈ %.4e (оценка разделёнными разностями)\n", bounds[i])
+		}
+		fmt.Fprintf(writer, "%-18s %12s %10s %12s %12s %12s\n", "Узлы", "max|f−P|", "в точке", "max|ω|", "Априорная", "Апостериорн.")
+		for _, r := range results[i] {
+			if r.err != nil {
+				fmt.Fprintf(writer, "%-18s ошибка: %v\n", r.set, r.err)
+				continue
+			}
+			apriori := "—"
+			if !math.IsNaN(r.apriori) {
+				apriori = fmt.Sprintf("%.4e", r.apriori)
+			}
+			fmt.Fprintf(writer, "%-18s %12.4e %10.4f %12.4e %12s %12.4e\n",
+				r.set, r.actual, r.actualAt, r.omega, apriori, r.aposterior)
+		}
+	}
+	return nil
+}